@@ -44,6 +44,12 @@ const (
 	GetBlockParamsFormatMsgpack GetBlockParamsFormat = "msgpack"
 )
 
+// Defines values for PendingTransactionInformationParamsFormat.
+const (
+	PendingTransactionInformationParamsFormatJson    PendingTransactionInformationParamsFormat = "json"
+	PendingTransactionInformationParamsFormatMsgpack PendingTransactionInformationParamsFormat = "msgpack"
+)
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -384,6 +390,21 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// Genesis The part of the genesis file the node is identified by.
+type Genesis struct {
+	// Devmode Whether the network only produces a round when a transaction is submitted.
+	Devmode *bool `json:"devmode,omitempty"`
+
+	// Id The id of the network, appended to its name in the genesis id.
+	Id string `json:"id"`
+
+	// Network The name of the network.
+	Network string `json:"network"`
+
+	// Proto The consensus protocol of the genesis.
+	Proto string `json:"proto"`
+}
+
 // ParticipationKey Represents a participation key used by the node.
 type ParticipationKey struct {
 	// Address Address the key was generated for.
@@ -574,6 +595,21 @@ type GetBlockParams struct {
 // GetBlockParamsFormat defines parameters for GetBlock.
 type GetBlockParamsFormat string
 
+// StartCatchupParams defines parameters for StartCatchup.
+type StartCatchupParams struct {
+	// Min Specify the minimum number of blocks which the ledger must be advanced by in order to start the catchup. This is useful for simplifying tools which support fast catchup, they run the catchup unconditionally and the node will skip the catchup if its not needed.
+	Min *int `form:"min,omitempty" json:"min,omitempty"`
+}
+
+// PendingTransactionInformationParams defines parameters for PendingTransactionInformation.
+type PendingTransactionInformationParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *PendingTransactionInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PendingTransactionInformationParamsFormat defines parameters for PendingTransactionInformation.
+type PendingTransactionInformationParamsFormat string
+
 // GenerateParticipationKeysParams defines parameters for GenerateParticipationKeys.
 type GenerateParticipationKeysParams struct {
 	// Dilution Key dilution for two-level participation keys (defaults to sqrt of validity window).
@@ -671,6 +707,15 @@ type ClientInterface interface {
 	// GetBlock request
 	GetBlock(ctx context.Context, round int, params *GetBlockParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AbortCatchup request
+	AbortCatchup(ctx context.Context, catchpoint string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartCatchup request
+	StartCatchup(ctx context.Context, catchpoint string, params *StartCatchupParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSupply request
+	GetSupply(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetParticipationKeys request
 	GetParticipationKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -695,6 +740,15 @@ type ClientInterface interface {
 	// WaitForBlock request
 	WaitForBlock(ctx context.Context, round int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RawTransactionWithBody request with any body
+	RawTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransactionParams request
+	TransactionParams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PendingTransactionInformation request
+	PendingTransactionInformation(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetVersion request
 	GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -747,6 +801,42 @@ func (c *Algod) GetBlock(ctx context.Context, round int, params *GetBlockParams,
 	return c.Client.Do(req)
 }
 
+func (c *Algod) AbortCatchup(ctx context.Context, catchpoint string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAbortCatchupRequest(c.Server, catchpoint)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) StartCatchup(ctx context.Context, catchpoint string, params *StartCatchupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartCatchupRequest(c.Server, catchpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) GetSupply(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSupplyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Algod) GetParticipationKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetParticipationKeysRequest(c.Server)
 	if err != nil {
@@ -843,6 +933,42 @@ func (c *Algod) WaitForBlock(ctx context.Context, round int, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
+func (c *Algod) RawTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRawTransactionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) TransactionParams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransactionParamsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) PendingTransactionInformation(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPendingTransactionInformationRequest(c.Server, txid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Algod) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetVersionRequest(c.Server)
 	if err != nil {
@@ -1037,6 +1163,123 @@ func NewGetBlockRequest(server string, round int, params *GetBlockParams) (*http
 	return req, nil
 }
 
+// NewAbortCatchupRequest generates requests for AbortCatchup
+func NewAbortCatchupRequest(server string, catchpoint string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catchpoint", runtime.ParamLocationPath, catchpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/catchup/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartCatchupRequest generates requests for StartCatchup
+func NewStartCatchupRequest(server string, catchpoint string, params *StartCatchupParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catchpoint", runtime.ParamLocationPath, catchpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/catchup/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Min != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min", runtime.ParamLocationQuery, *params.Min); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSupplyRequest generates requests for GetSupply
+func NewGetSupplyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/ledger/supply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetParticipationKeysRequest generates requests for GetParticipationKeys
 func NewGetParticipationKeysRequest(server string) (*http.Request, error) {
 	var err error
@@ -1338,8 +1581,8 @@ func NewWaitForBlockRequest(server string, round int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetVersionRequest generates requests for GetVersion
-func NewGetVersionRequest(server string) (*http.Request, error) {
+// NewRawTransactionRequestWithBody generates requests for RawTransaction with any type of body
+func NewRawTransactionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1347,7 +1590,7 @@ func NewGetVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/versions")
+	operationPath := fmt.Sprintf("/v2/transactions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1357,68 +1600,189 @@ func NewGetVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Algod) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewTransactionParamsRequest generates requests for TransactionParams
+func NewTransactionParamsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/v2/transactions/params")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Algod) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetGenesisWithResponse request
-	GetGenesisWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGenesisResponse, error)
+// NewPendingTransactionInformationRequest generates requests for PendingTransactionInformation
+func NewPendingTransactionInformationRequest(server string, txid string, params *PendingTransactionInformationParams) (*http.Request, error) {
+	var err error
 
-	// MetricsWithResponse request
-	MetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetricsResponse, error)
+	var pathParam0 string
 
-	// AccountInformationWithResponse request
-	AccountInformationWithResponse(ctx context.Context, address string, params *AccountInformationParams, reqEditors ...RequestEditorFn) (*AccountInformationResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetBlockWithResponse request
-	GetBlockWithResponse(ctx context.Context, round int, params *GetBlockParams, reqEditors ...RequestEditorFn) (*GetBlockResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/transactions/pending/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVersionRequest generates requests for GetVersion
+func NewGetVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/versions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Algod) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Algod) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetGenesisWithResponse request
+	GetGenesisWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGenesisResponse, error)
+
+	// MetricsWithResponse request
+	MetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetricsResponse, error)
+
+	// AccountInformationWithResponse request
+	AccountInformationWithResponse(ctx context.Context, address string, params *AccountInformationParams, reqEditors ...RequestEditorFn) (*AccountInformationResponse, error)
+
+	// GetBlockWithResponse request
+	GetBlockWithResponse(ctx context.Context, round int, params *GetBlockParams, reqEditors ...RequestEditorFn) (*GetBlockResponse, error)
+
+	// AbortCatchupWithResponse request
+	AbortCatchupWithResponse(ctx context.Context, catchpoint string, reqEditors ...RequestEditorFn) (*AbortCatchupResponse, error)
+
+	// StartCatchupWithResponse request
+	StartCatchupWithResponse(ctx context.Context, catchpoint string, params *StartCatchupParams, reqEditors ...RequestEditorFn) (*StartCatchupResponse, error)
+
+	// GetSupplyWithResponse request
+	GetSupplyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSupplyResponse, error)
 
 	// GetParticipationKeysWithResponse request
 	GetParticipationKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetParticipationKeysResponse, error)
@@ -1444,6 +1808,15 @@ type ClientWithResponsesInterface interface {
 	// WaitForBlockWithResponse request
 	WaitForBlockWithResponse(ctx context.Context, round int, reqEditors ...RequestEditorFn) (*WaitForBlockResponse, error)
 
+	// RawTransactionWithBodyWithResponse request with any body
+	RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RawTransactionResponse, error)
+
+	// TransactionParamsWithResponse request
+	TransactionParamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransactionParamsResponse, error)
+
+	// PendingTransactionInformationWithResponse request
+	PendingTransactionInformationWithResponse(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*PendingTransactionInformationResponse, error)
+
 	// GetVersionWithResponse request
 	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
 }
@@ -1451,7 +1824,7 @@ type ClientWithResponsesInterface interface {
 type GetGenesisResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *string
+	JSON200      *Genesis
 }
 
 // Status returns HTTPResponse.Status
@@ -1548,6 +1921,99 @@ func (r GetBlockResponse) StatusCode() int {
 	return 0
 }
 
+type AbortCatchupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CatchupMessage Catchup abort response string
+		CatchupMessage string `json:"catchup-message"`
+	}
+	JSON400 *ErrorResponse
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AbortCatchupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AbortCatchupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartCatchupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CatchupMessage Catchup start response string
+		CatchupMessage string `json:"catchup-message"`
+	}
+	JSON201 *struct {
+		// CatchupMessage Catchup start response string
+		CatchupMessage string `json:"catchup-message"`
+	}
+	JSON400 *ErrorResponse
+	JSON401 *ErrorResponse
+	JSON408 *ErrorResponse
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartCatchupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartCatchupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSupplyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentRound Round
+		CurrentRound int `json:"current_round"`
+
+		// OnlineMoney OnlineMoney
+		OnlineMoney int `json:"online-money"`
+
+		// TotalMoney TotalMoney
+		TotalMoney int `json:"total-money"`
+	}
+	JSON401 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSupplyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSupplyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetParticipationKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1913,6 +2379,110 @@ func (r WaitForBlockResponse) StatusCode() int {
 	return 0
 }
 
+type RawTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// TxId encoding of the transaction hash.
+		TxId string `json:"txId"`
+	}
+	JSON400 *ErrorResponse
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+	JSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RawTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RawTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransactionParamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// ConsensusVersion ConsensusVersion indicates the consensus protocol version
+		// as of LastRound.
+		ConsensusVersion string `json:"consensus-version"`
+
+		// Fee Fee is the suggested transaction fee
+		// Fee is in units of micro-Algos per byte.
+		// Fee may fall to zero but transactions must still have a fee of
+		// at least MinTxnFee for the current network protocol.
+		Fee int `json:"fee"`
+
+		// GenesisHash GenesisHash is the hash of the genesis block.
+		GenesisHash []byte `json:"genesis-hash"`
+
+		// GenesisId GenesisID is an ID listed in the genesis block.
+		GenesisId string `json:"genesis-id"`
+
+		// LastRound LastRound indicates the last round seen
+		LastRound int `json:"last-round"`
+
+		// MinFee The minimum transaction fee (not per byte) required for the
+		// txn to validate for the current network protocol.
+		MinFee int `json:"min-fee"`
+	}
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+	JSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TransactionParamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransactionParamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PendingTransactionInformationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingTransactionResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PendingTransactionInformationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PendingTransactionInformationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1971,6 +2541,33 @@ func (c *ClientWithResponses) GetBlockWithResponse(ctx context.Context, round in
 	return ParseGetBlockResponse(rsp)
 }
 
+// AbortCatchupWithResponse request returning *AbortCatchupResponse
+func (c *ClientWithResponses) AbortCatchupWithResponse(ctx context.Context, catchpoint string, reqEditors ...RequestEditorFn) (*AbortCatchupResponse, error) {
+	rsp, err := c.AbortCatchup(ctx, catchpoint, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAbortCatchupResponse(rsp)
+}
+
+// StartCatchupWithResponse request returning *StartCatchupResponse
+func (c *ClientWithResponses) StartCatchupWithResponse(ctx context.Context, catchpoint string, params *StartCatchupParams, reqEditors ...RequestEditorFn) (*StartCatchupResponse, error) {
+	rsp, err := c.StartCatchup(ctx, catchpoint, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartCatchupResponse(rsp)
+}
+
+// GetSupplyWithResponse request returning *GetSupplyResponse
+func (c *ClientWithResponses) GetSupplyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSupplyResponse, error) {
+	rsp, err := c.GetSupply(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSupplyResponse(rsp)
+}
+
 // GetParticipationKeysWithResponse request returning *GetParticipationKeysResponse
 func (c *ClientWithResponses) GetParticipationKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetParticipationKeysResponse, error) {
 	rsp, err := c.GetParticipationKeys(ctx, reqEditors...)
@@ -2043,6 +2640,33 @@ func (c *ClientWithResponses) WaitForBlockWithResponse(ctx context.Context, roun
 	return ParseWaitForBlockResponse(rsp)
 }
 
+// RawTransactionWithBodyWithResponse request with arbitrary body returning *RawTransactionResponse
+func (c *ClientWithResponses) RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RawTransactionResponse, error) {
+	rsp, err := c.RawTransactionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRawTransactionResponse(rsp)
+}
+
+// TransactionParamsWithResponse request returning *TransactionParamsResponse
+func (c *ClientWithResponses) TransactionParamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransactionParamsResponse, error) {
+	rsp, err := c.TransactionParams(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransactionParamsResponse(rsp)
+}
+
+// PendingTransactionInformationWithResponse request returning *PendingTransactionInformationResponse
+func (c *ClientWithResponses) PendingTransactionInformationWithResponse(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*PendingTransactionInformationResponse, error) {
+	rsp, err := c.PendingTransactionInformation(ctx, txid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePendingTransactionInformationResponse(rsp)
+}
+
 // GetVersionWithResponse request returning *GetVersionResponse
 func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
 	rsp, err := c.GetVersion(ctx, reqEditors...)
@@ -2067,7 +2691,7 @@ func ParseGetGenesisResponse(rsp *http.Response) (*GetGenesisResponse, error) {
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest string
+		var dest Genesis
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2086,30 +2710,167 @@ func ParseMetricsResponse(rsp *http.Response) (*MetricsResponse, error) {
 		return nil, err
 	}
 
-	response := &MetricsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	response := &MetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAccountInformationResponse parses an HTTP response from a AccountInformationWithResponse call
+func ParseAccountInformationResponse(rsp *http.Response) (*AccountInformationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AccountInformationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 401:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 500:
+		// Content-type (application/msgpack) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBlockResponse parses an HTTP response from a GetBlockWithResponse call
+func ParseGetBlockResponse(rsp *http.Response) (*GetBlockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBlockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Block Block header data.
+			Block map[string]interface{} `json:"block"`
+
+			// Cert Optional certificate object. This is only included when the format is set to message pack.
+			Cert *map[string]interface{} `json:"cert,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 401:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 500:
+		// Content-type (application/msgpack) unsupported
+
 	}
 
 	return response, nil
 }
 
-// ParseAccountInformationResponse parses an HTTP response from a AccountInformationWithResponse call
-func ParseAccountInformationResponse(rsp *http.Response) (*AccountInformationResponse, error) {
+// ParseAbortCatchupResponse parses an HTTP response from a AbortCatchupWithResponse call
+func ParseAbortCatchupResponse(rsp *http.Response) (*AbortCatchupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AccountInformationResponse{
+	response := &AbortCatchupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Account
+		var dest struct {
+			// CatchupMessage Catchup abort response string
+			CatchupMessage string `json:"catchup-message"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2136,32 +2897,20 @@ func ParseAccountInformationResponse(rsp *http.Response) (*AccountInformationRes
 		}
 		response.JSON500 = &dest
 
-	case rsp.StatusCode == 200:
-	// Content-type (application/msgpack) unsupported
-
-	case rsp.StatusCode == 400:
-	// Content-type (application/msgpack) unsupported
-
-	case rsp.StatusCode == 401:
-	// Content-type (application/msgpack) unsupported
-
-	case rsp.StatusCode == 500:
-		// Content-type (application/msgpack) unsupported
-
 	}
 
 	return response, nil
 }
 
-// ParseGetBlockResponse parses an HTTP response from a GetBlockWithResponse call
-func ParseGetBlockResponse(rsp *http.Response) (*GetBlockResponse, error) {
+// ParseStartCatchupResponse parses an HTTP response from a StartCatchupWithResponse call
+func ParseStartCatchupResponse(rsp *http.Response) (*StartCatchupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBlockResponse{
+	response := &StartCatchupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2169,17 +2918,24 @@ func ParseGetBlockResponse(rsp *http.Response) (*GetBlockResponse, error) {
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			// Block Block header data.
-			Block map[string]interface{} `json:"block"`
-
-			// Cert Optional certificate object. This is only included when the format is set to message pack.
-			Cert *map[string]interface{} `json:"cert,omitempty"`
+			// CatchupMessage Catchup start response string
+			CatchupMessage string `json:"catchup-message"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			// CatchupMessage Catchup start response string
+			CatchupMessage string `json:"catchup-message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2194,12 +2950,12 @@ func ParseGetBlockResponse(rsp *http.Response) (*GetBlockResponse, error) {
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON408 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -2208,20 +2964,47 @@ func ParseGetBlockResponse(rsp *http.Response) (*GetBlockResponse, error) {
 		}
 		response.JSON500 = &dest
 
-	case rsp.StatusCode == 200:
-	// Content-type (application/msgpack) unsupported
+	}
 
-	case rsp.StatusCode == 400:
-	// Content-type (application/msgpack) unsupported
+	return response, nil
+}
 
-	case rsp.StatusCode == 401:
-	// Content-type (application/msgpack) unsupported
+// ParseGetSupplyResponse parses an HTTP response from a GetSupplyWithResponse call
+func ParseGetSupplyResponse(rsp *http.Response) (*GetSupplyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	case rsp.StatusCode == 404:
-	// Content-type (application/msgpack) unsupported
+	response := &GetSupplyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	case rsp.StatusCode == 500:
-		// Content-type (application/msgpack) unsupported
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentRound Round
+			CurrentRound int `json:"current_round"`
+
+			// OnlineMoney OnlineMoney
+			OnlineMoney int `json:"online-money"`
+
+			// TotalMoney TotalMoney
+			TotalMoney int `json:"total-money"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
 	}
 
@@ -2805,6 +3588,212 @@ func ParseWaitForBlockResponse(rsp *http.Response) (*WaitForBlockResponse, error
 	return response, nil
 }
 
+// ParseRawTransactionResponse parses an HTTP response from a RawTransactionWithResponse call
+func ParseRawTransactionResponse(rsp *http.Response) (*RawTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RawTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// TxId encoding of the transaction hash.
+			TxId string `json:"txId"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransactionParamsResponse parses an HTTP response from a TransactionParamsWithResponse call
+func ParseTransactionParamsResponse(rsp *http.Response) (*TransactionParamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransactionParamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// ConsensusVersion ConsensusVersion indicates the consensus protocol version
+			// as of LastRound.
+			ConsensusVersion string `json:"consensus-version"`
+
+			// Fee Fee is the suggested transaction fee
+			// Fee is in units of micro-Algos per byte.
+			// Fee may fall to zero but transactions must still have a fee of
+			// at least MinTxnFee for the current network protocol.
+			Fee int `json:"fee"`
+
+			// GenesisHash GenesisHash is the hash of the genesis block.
+			GenesisHash []byte `json:"genesis-hash"`
+
+			// GenesisId GenesisID is an ID listed in the genesis block.
+			GenesisId string `json:"genesis-id"`
+
+			// LastRound LastRound indicates the last round seen
+			LastRound int `json:"last-round"`
+
+			// MinFee The minimum transaction fee (not per byte) required for the
+			// txn to validate for the current network protocol.
+			MinFee int `json:"min-fee"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePendingTransactionInformationResponse parses an HTTP response from a PendingTransactionInformationWithResponse call
+func ParsePendingTransactionInformationResponse(rsp *http.Response) (*PendingTransactionInformationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PendingTransactionInformationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingTransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case rsp.StatusCode == 200:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 401:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 500:
+	// Content-type (application/msgpack) unsupported
+
+	case rsp.StatusCode == 503:
+		// Content-type (application/msgpack) unsupported
+
+	}
+
+	return response, nil
+}
+
 // ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
 func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
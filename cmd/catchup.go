@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	catchupOutput string
+	catchupFile   string
+	catchupMin    int
+)
+
+// catchupCmd is the parent command for fast catchup control
+var catchupCmd = &cobra.Command{
+	Use:          "catchup",
+	Short:        "Manage fast catchup",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Start, abort and follow a fast catchup"),
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		switch OutputFormat(catchupOutput) {
+		case TableOutput, JSONOutput:
+		default:
+			return exitWith(ExitUsage, fmt.Errorf("invalid output format: %s", catchupOutput))
+		}
+		return requireAlgod()
+	},
+}
+
+// catchupStartCmd starts a fast catchup from a label or a catchpoint file
+var catchupStartCmd = &cobra.Command{
+	Use:   "start [catchpoint]",
+	Short: "Start a fast catchup",
+	Long:  style.Purple(BANNER) + "\n" + style.LightBlue("Start a fast catchup, without a catchpoint the last catchpoint of the reference node is used"),
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		var catchpoint string
+		if len(args) == 0 && catchupFile == "" && viper.GetString("reference-endpoint") != "" {
+			catchpoint, err = referenceCatchpoint(ctx, client)
+		} else {
+			catchpoint, err = resolveCatchpoint(args, catchupFile)
+		}
+		if err != nil {
+			return err
+		}
+		return startCatchup(ctx, client, cmd.OutOrStdout(), OutputFormat(catchupOutput), catchpoint, catchupMin)
+	},
+}
+
+// catchupAbortCmd stops a fast catchup, defaulting to the one in progress
+var catchupAbortCmd = &cobra.Command{
+	Use:   "abort [catchpoint]",
+	Short: "Abort a fast catchup",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		catchpoint := ""
+		if len(args) > 0 {
+			catchpoint = args[0]
+		}
+		return abortCatchup(context.Background(), client, cmd.OutOrStdout(), OutputFormat(catchupOutput), catchpoint)
+	},
+}
+
+// catchupStatusCmd prints the progress of the current fast catchup
+var catchupStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the fast catchup progress",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return catchupStatus(context.Background(), client, cmd.OutOrStdout(), OutputFormat(catchupOutput))
+	},
+}
+
+func init() {
+	catchupCmd.PersistentFlags().StringVarP(&catchupOutput, "output", "o", string(TableOutput), style.LightBlue("output format, one of table|json"))
+
+	catchupStartCmd.Flags().StringVarP(&catchupFile, "file", "f", "", style.LightBlue("read the catchpoint from a file"))
+	catchupStartCmd.Flags().IntVar(&catchupMin, "min", 0, style.LightBlue("only catchup when the node is at least this many rounds behind"))
+
+	catchupCmd.AddCommand(catchupStartCmd)
+	catchupCmd.AddCommand(catchupAbortCmd)
+	catchupCmd.AddCommand(catchupStatusCmd)
+}
+
+// resolveCatchpoint picks the catchpoint from the arguments or the --file flag
+func resolveCatchpoint(args []string, file string) (string, error) {
+	if len(args) > 0 && file != "" {
+		return "", exitWith(ExitUsage, errors.New("use either a catchpoint argument or --file, not both"))
+	}
+	if file != "" {
+		catchpoint, err := internal.ReadCatchpointFile(file)
+		if err != nil {
+			return "", exitWith(ExitUsage, err)
+		}
+		return catchpoint, nil
+	}
+	if len(args) == 0 {
+		return "", exitWith(ExitUsage, errors.New("a catchpoint argument, --file or a reference-endpoint is required"))
+	}
+	if !internal.IsValidCatchpoint(args[0]) {
+		return "", exitWith(ExitUsage, fmt.Errorf("invalid catchpoint: %s", args[0]))
+	}
+	return args[0], nil
+}
+
+// referenceCatchpoint reads the last catchpoint of the reference node of the configuration
+func referenceCatchpoint(ctx context.Context, client api.ClientWithResponsesInterface) (string, error) {
+	v, err := client.GetVersionWithResponse(ctx)
+	if err == nil && v.StatusCode() != 200 {
+		err = errors.New(v.Status())
+	}
+	if err != nil {
+		return "", exitWith(ExitFailure, fmt.Errorf("failed to get the network of the node: %w", err))
+	}
+	reference := getReference(ctx, base64.StdEncoding.EncodeToString(v.JSON200.GenesisHashB64))
+	if reference == nil {
+		return "", exitWith(ExitFailure, errors.New("the reference node is unavailable"))
+	}
+	var sync internal.SyncModel
+	err = sync.UpdateNetwork(ctx, reference, time.Now())
+	if err != nil {
+		return "", exitWith(ExitFailure, fmt.Errorf("failed to get the reference status: %w", err))
+	}
+	if !internal.IsValidCatchpoint(sync.Catchpoint) {
+		return "", exitWith(ExitFailure, errors.New("the reference node has no catchpoint"))
+	}
+	return sync.Catchpoint, nil
+}
+
+// startCatchup starts a fast catchup and writes the algod message
+func startCatchup(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, format OutputFormat, catchpoint string, min int) error {
+	var minRounds *int
+	if min > 0 {
+		minRounds = &min
+	}
+	msg, err := internal.StartCatchup(ctx, client, catchpoint, minRounds)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to start catchup: %w", err))
+	}
+	return writeCatchupMessage(out, format, catchpoint, msg)
+}
+
+// abortCatchup stops a fast catchup and writes the algod message
+func abortCatchup(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, format OutputFormat, catchpoint string) error {
+	if catchpoint == "" {
+		catchup, err := getCatchup(ctx, client)
+		if err != nil {
+			return err
+		}
+		if !catchup.Active() {
+			return exitWith(ExitFailure, errors.New("the node is not catching up"))
+		}
+		catchpoint = catchup.Label
+	}
+	msg, err := internal.AbortCatchup(ctx, client, catchpoint)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to abort catchup: %w", err))
+	}
+	return writeCatchupMessage(out, format, catchpoint, msg)
+}
+
+// catchupStatus writes the progress of the current fast catchup
+func catchupStatus(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, format OutputFormat) error {
+	catchup, err := getCatchup(ctx, client)
+	if err != nil {
+		return err
+	}
+	if format == JSONOutput {
+		return writeJSON(out, map[string]interface{}{
+			"active":            catchup.Active(),
+			"catchpoint":        catchup.Label,
+			"progress":          catchup.Progress(),
+			"totalAccounts":     catchup.TotalAccounts,
+			"processedAccounts": catchup.ProcessedAccounts,
+			"verifiedAccounts":  catchup.VerifiedAccounts,
+			"totalKvs":          catchup.TotalKvs,
+			"processedKvs":      catchup.ProcessedKvs,
+			"verifiedKvs":       catchup.VerifiedKvs,
+			"totalBlocks":       catchup.TotalBlocks,
+			"acquiredBlocks":    catchup.AcquiredBlocks,
+		})
+	}
+	if !catchup.Active() {
+		_, err = fmt.Fprintln(out, "The node is not catching up")
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Catchpoint:\t%s\n", catchup.Label)
+	_, _ = fmt.Fprintf(w, "Progress:\t%.1f%%\n", catchup.Progress()*100)
+	_, _ = fmt.Fprintf(w, "Accounts Processed:\t%d/%d\n", catchup.ProcessedAccounts, catchup.TotalAccounts)
+	_, _ = fmt.Fprintf(w, "Accounts Verified:\t%d/%d\n", catchup.VerifiedAccounts, catchup.TotalAccounts)
+	_, _ = fmt.Fprintf(w, "KVs Processed:\t%d/%d\n", catchup.ProcessedKvs, catchup.TotalKvs)
+	_, _ = fmt.Fprintf(w, "KVs Verified:\t%d/%d\n", catchup.VerifiedKvs, catchup.TotalKvs)
+	_, _ = fmt.Fprintf(w, "Blocks Acquired:\t%d/%d\n", catchup.AcquiredBlocks, catchup.TotalBlocks)
+	return w.Flush()
+}
+
+// getCatchup reads the catchpoint progress from the node status
+func getCatchup(ctx context.Context, client api.ClientWithResponsesInterface) (*internal.CatchpointModel, error) {
+	s, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return nil, exitWith(ExitFailure, fmt.Errorf("failed to get status: %w", err))
+	}
+	if s.StatusCode() != 200 {
+		return nil, exitWith(ExitFailure, fmt.Errorf("failed to get status: %s", s.Status()))
+	}
+	catchup := internal.CatchpointModel{}
+	catchup.Update(s.JSON200, time.Now())
+	return &catchup, nil
+}
+
+// writeCatchupMessage prints the response of a start or abort request
+func writeCatchupMessage(out io.Writer, format OutputFormat, catchpoint string, msg string) error {
+	if format == JSONOutput {
+		return writeJSON(out, map[string]string{"catchpoint": catchpoint, "message": msg})
+	}
+	_, err := fmt.Fprintln(out, msg)
+	return err
+}
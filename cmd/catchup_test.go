@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"github.com/spf13/viper"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testCatchpoint = "4420000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA"
+
+func Test_ResolveCatchpoint(t *testing.T) {
+	catchpoint, err := resolveCatchpoint([]string{testCatchpoint}, "")
+	if err != nil || catchpoint != testCatchpoint {
+		t.Errorf("expected %s, got %s %v", testCatchpoint, catchpoint, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "catchpoint")
+	err = os.WriteFile(path, []byte(testCatchpoint), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	catchpoint, err = resolveCatchpoint(nil, path)
+	if err != nil || catchpoint != testCatchpoint {
+		t.Errorf("expected %s from the file, got %s %v", testCatchpoint, catchpoint, err)
+	}
+
+	var exitErr *ExitError
+	for _, args := range [][]string{nil, {"invalid"}, {testCatchpoint, path}} {
+		file := ""
+		if len(args) == 2 {
+			file = args[1]
+			args = args[:1]
+		}
+		_, err = resolveCatchpoint(args, file)
+		if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+			t.Errorf("expected exit code %d for %v, got %v", ExitUsage, args, err)
+		}
+	}
+}
+
+func Test_ReferenceCatchpoint(t *testing.T) {
+	ctx := context.Background()
+	algod := fake.New()
+	defer algod.Close()
+	reference := fake.New()
+	reference.Token = ""
+	reference.LastCatchpoint = testCatchpoint
+	defer reference.Close()
+	other := fake.New()
+	other.Token = ""
+	other.GenesisId = "othernet-v1"
+	defer other.Close()
+	defer viper.Set("reference-endpoint", "")
+
+	viper.Set("reference-endpoint", reference.URL)
+	catchpoint, err := referenceCatchpoint(ctx, algod.Client())
+	if err != nil || catchpoint != testCatchpoint {
+		t.Errorf("expected the catchpoint of the reference, got %s %v", catchpoint, err)
+	}
+
+	var exitErr *ExitError
+	for _, url := range []string{other.URL, algod.URL} {
+		viper.Set("reference-endpoint", url)
+		_, err = referenceCatchpoint(ctx, algod.Client())
+		if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+			t.Errorf("expected the reference %s to be refused, got %v", url, err)
+		}
+	}
+}
+
+func Test_StartCatchup(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	err := startCatchup(ctx, test.GetClient(false), &out, JSONOutput, testCatchpoint, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"catchpoint": "`+testCatchpoint+`"`) {
+		t.Errorf("expected the catchpoint, got %s", out.String())
+	}
+
+	err = startCatchup(ctx, test.NewClient(false, true), &out, TableOutput, testCatchpoint, 0)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+		t.Errorf("expected exit code %d, got %v", ExitFailure, err)
+	}
+}
+
+func Test_AbortCatchup(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	err := abortCatchup(ctx, test.GetClient(false), &out, TableOutput, testCatchpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(out.String()) != testCatchpoint {
+		t.Errorf("expected the algod message, got %s", out.String())
+	}
+
+	// The mock node is not catching up
+	err = abortCatchup(ctx, test.GetClient(false), &out, TableOutput, "")
+	if err == nil {
+		t.Error("expected an error without a catchup in progress")
+	}
+}
+
+func Test_CatchupStatus(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	err := catchupStatus(ctx, test.GetClient(false), &out, TableOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "not catching up") {
+		t.Errorf("expected an inactive catchup, got %s", out.String())
+	}
+
+	out.Reset()
+	err = catchupStatus(ctx, test.GetClient(false), &out, JSONOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"active": false`) {
+		t.Errorf("expected an inactive catchup, got %s", out.String())
+	}
+}
+
+func Test_CatchupFakeAlgod(t *testing.T) {
+	ctx := context.Background()
+	algod := fake.New(fake.WithRound(1000))
+	defer algod.Close()
+	client := algod.Client()
+
+	var out bytes.Buffer
+	err := startCatchup(ctx, client, &out, TableOutput, testCatchpoint, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	err = catchupStatus(ctx, client, &out, TableOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Accounts Processed:") {
+		t.Errorf("expected the catchup progress, got %s", out.String())
+	}
+
+	// Abort the catchup in progress
+	out.Reset()
+	err = abortCatchup(ctx, client, &out, JSONOutput, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), testCatchpoint) {
+		t.Errorf("expected the aborted catchpoint, got %s", out.String())
+	}
+}
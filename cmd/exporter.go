@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var exporterListen string
+
+// exporterCmd serves the node and account state as Prometheus metrics
+var exporterCmd = &cobra.Command{
+	Use:          "exporter",
+	Short:        "Export Prometheus metrics",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Serve the node and account state as Prometheus metrics"),
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		err := requireAlgod()
+		if err != nil {
+			return err
+		}
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		listener, err := net.Listen("tcp", exporterListen)
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+		state := internal.StateModel{
+			Status: internal.StatusModel{
+				State:       internal.SyncingState,
+				Version:     "N/A",
+				Network:     "N/A",
+				NeedsUpdate: true,
+			},
+			Client: client,
+		}
+		return exitWith(ExitFailure, serveExporter(ctx, state, getRecorder(), listener, cmd.OutOrStdout()))
+	},
+}
+
+func init() {
+	exporterCmd.Flags().StringVar(&exporterListen, "listen", ":9100", style.LightBlue("address to serve /metrics on"))
+}
+
+// serveExporter watches the node of the state and serves /metrics until the context is cancelled,
+// the recorder is optional
+func serveExporter(ctx context.Context, state internal.StateModel, recorder *internal.Recorder, listener net.Listener, out io.Writer) error {
+	exporter := new(internal.Exporter)
+	watcher := internal.NewWatcher(&state, state.Client)
+	watcher.Recorder = recorder
+	watcher.StallFactor = viper.GetInt("stall-factor")
+	watcher.Logger = log.Default()
+	updates, _ := watcher.Subscribe(1)
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+	go func() {
+		for update := range updates {
+			exporter.Update(update)
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", exporter)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: time.Second * 5}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		_ = server.Shutdown(shutdown)
+	}()
+
+	fmt.Fprintf(out, "Serving metrics on http://%s/metrics\n", listener.Addr())
+	err := server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ExporterFakeAlgod(t *testing.T) {
+	address := fake.Address("exporter")
+	algod := fake.New(
+		fake.WithRound(1000),
+		fake.WithRoundTime(time.Millisecond*20),
+		fake.WithKeys(api.ParticipationKey{
+			Address: address,
+			Id:      "exporter-key",
+			Key:     api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 100000, VoteKeyDilution: 100},
+		}),
+		fake.WithScenarios(fake.AccountOnlineAt(1001, address)),
+	)
+	defer algod.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	state := internal.StateModel{
+		Status: internal.StatusModel{State: internal.StableState, Version: "v3.0.0-stable"},
+		Client: algod.Client(),
+	}
+	var out bytes.Buffer
+	done := make(chan error)
+	go func() {
+		done <- serveExporter(ctx, state, nil, listener, &out)
+	}()
+
+	url := "http://" + listener.Addr().String() + "/metrics"
+	expected := `algorun_account_online{address="` + address + `"} 1`
+	deadline := time.Now().Add(time.Second * 5)
+	var body string
+	for time.Now().Before(deadline) && !strings.Contains(body, expected) {
+		time.Sleep(time.Millisecond * 50)
+		res, err := http.Get(url)
+		if err != nil {
+			continue
+		}
+		b, _ := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		body = string(b)
+	}
+	if !strings.Contains(body, expected) || !strings.Contains(body, "algorun_up 1") {
+		t.Errorf("expected the account online, got %s", body)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("the exporter did not stop")
+	}
+}
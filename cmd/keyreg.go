@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	keyregFile      string
+	keyregTxnFile   string
+	keyregOnline    bool
+	keyregOffline   bool
+	keyregAddress   string
+	keyregThreshold int
+	keyregSigners   []string
+	keyregFeePolicy string
+)
+
+// keyregCmd is the parent command for key registration transactions
+var keyregCmd = &cobra.Command{
+	Use:          "keyreg",
+	Short:        "Build and submit key registration transactions",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Register participation keys for accounts that can't sign with a wallet"),
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return requireAlgod()
+	},
+}
+
+// keyregExportCmd writes an unsigned key registration for offline signing
+var keyregExportCmd = &cobra.Command{
+	Use:   "export <key-id>",
+	Short: "Export an unsigned key registration",
+	Long: style.LightBlue("Export an unsigned key registration for air-gapped signing.\n" +
+		"The file is accepted by goal clerk sign and hardware wallets."),
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		policy, err := getFeePolicy(cmd)
+		if err != nil {
+			return err
+		}
+		return exportKeyreg(context.Background(), client, cmd.OutOrStdout(), args[0], keyregOffline, policy, keyregTxnFile)
+	},
+}
+
+// keyregSubmitCmd broadcasts a signed key registration and waits for its confirmation
+var keyregSubmitCmd = &cobra.Command{
+	Use:   "submit <file>",
+	Short: "Submit a signed key registration",
+	Long: style.LightBlue("Submit a key registration signed with goal clerk sign or a hardware wallet\n" +
+		"and wait for it to be confirmed."),
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return submitKeyreg(context.Background(), client, cmd.OutOrStdout(), args[0])
+	},
+}
+
+// keyregMultisigCmd groups the partial signing flow of multisig accounts
+var keyregMultisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "Register keys for multisig and rekeyed to multisig accounts",
+}
+
+// keyregMultisigExportCmd writes an unsigned key registration for the signers of a multisig account
+var keyregMultisigExportCmd = &cobra.Command{
+	Use:   "export [key-id]",
+	Short: "Export an unsigned key registration for partial signing",
+	Long: style.LightBlue("Export an unsigned key registration for partial signing.\n" +
+		"Each signer signs a copy of the file, for example with goal clerk multisig sign,\n" +
+		"then the copies are combined with algorun keyreg multisig submit."),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.MaximumNArgs(1)(cmd, args); err != nil {
+			return err
+		}
+		if (len(args) == 0) == !keyregOffline {
+			return exitWith(ExitUsage, errors.New("either a participation key id or --offline is required"))
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := ""
+		if len(args) > 0 {
+			id = args[0]
+		}
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		policy, err := getFeePolicy(cmd)
+		if err != nil {
+			return err
+		}
+		return exportMultisigKeyreg(context.Background(), client, cmd.OutOrStdout(), id, keyregAddress, keyregThreshold, keyregSigners, policy, keyregFile)
+	},
+}
+
+// keyregMultisigSubmitCmd merges the signed copies of an exported key registration and submits it
+var keyregMultisigSubmitCmd = &cobra.Command{
+	Use:   "submit <file>...",
+	Short: "Merge the partially signed copies and submit the key registration",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return submitMultisigKeyreg(context.Background(), client, cmd.OutOrStdout(), args)
+	},
+}
+
+func init() {
+	keyregExportCmd.Flags().BoolVar(&keyregOnline, "online", false, style.LightBlue("register the participation key"))
+	keyregExportCmd.Flags().BoolVar(&keyregOffline, "offline", false, style.LightBlue("register the account of the participation key offline"))
+	keyregExportCmd.Flags().StringVarP(&keyregTxnFile, "out", "o", "keyreg.txn", style.LightBlue("file to write the unsigned transaction to"))
+	keyregExportCmd.Flags().StringVar(&keyregFeePolicy, "incentive-fee", "", style.LightBlue("when the online key registration pays the 2 ALGO incentive eligibility fee, one of always|never|when-not-eligible, the incentive-fee of the configuration by default"))
+	keyregExportCmd.MarkFlagsMutuallyExclusive("online", "offline")
+	keyregExportCmd.MarkFlagsOneRequired("online", "offline")
+
+	keyregMultisigExportCmd.Flags().BoolVar(&keyregOffline, "offline", false, style.LightBlue("register the account offline instead of a participation key"))
+	keyregMultisigExportCmd.Flags().StringVar(&keyregAddress, "address", "", style.LightBlue("account address to register offline"))
+	keyregMultisigExportCmd.Flags().IntVar(&keyregThreshold, "threshold", 0, style.LightBlue("number of signatures required by the multisig account"))
+	keyregMultisigExportCmd.Flags().StringArrayVar(&keyregSigners, "signer", nil, style.LightBlue("address of a multisig signer, repeated in the order of the multisig account"))
+	keyregMultisigExportCmd.Flags().StringVarP(&keyregFile, "out", "o", "keyreg.msig", style.LightBlue("file to write the unsigned transaction to"))
+	keyregMultisigExportCmd.Flags().StringVar(&keyregFeePolicy, "incentive-fee", "", style.LightBlue("when the online key registration pays the 2 ALGO incentive eligibility fee, one of always|never|when-not-eligible, the incentive-fee of the configuration by default"))
+	_ = keyregMultisigExportCmd.MarkFlagRequired("threshold")
+	_ = keyregMultisigExportCmd.MarkFlagRequired("signer")
+	keyregMultisigExportCmd.MarkFlagsRequiredTogether("offline", "address")
+
+	keyregCmd.AddCommand(keyregExportCmd)
+	keyregCmd.AddCommand(keyregSubmitCmd)
+	keyregMultisigCmd.AddCommand(keyregMultisigExportCmd)
+	keyregMultisigCmd.AddCommand(keyregMultisigSubmitCmd)
+	keyregCmd.AddCommand(keyregMultisigCmd)
+}
+
+// getFeePolicy is the --incentive-fee of a command, or the incentive-fee of the configuration
+func getFeePolicy(cmd *cobra.Command) (internal.FeePolicy, error) {
+	policy := keyregFeePolicy
+	if !cmd.Flags().Changed("incentive-fee") {
+		policy = viper.GetString("incentive-fee")
+	}
+	feePolicy, err := internal.ParseFeePolicy(policy)
+	if err != nil {
+		return "", exitWith(ExitUsage, err)
+	}
+	return feePolicy, nil
+}
+
+// buildKeyreg makes an online key registration for the key paying the fee of the policy,
+// or an offline one for address when the key is nil, and returns the account it registers
+func buildKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, key *api.ParticipationKey, address string, policy internal.FeePolicy) (types.Transaction, internal.Account, error) {
+	if !internal.ValidateAddress(address) {
+		return types.Transaction{}, internal.Account{}, exitWith(ExitUsage, fmt.Errorf("invalid address: %s", address))
+	}
+	rpcAccount, err := internal.GetAccount(client, address)
+	if err != nil {
+		return types.Transaction{}, internal.Account{}, exitWith(ExitFailure, fmt.Errorf("failed to get account %s: %w", address, err))
+	}
+	account := internal.UpdateAccountFromRPC(internal.Account{Address: address}, rpcAccount)
+
+	var fee uint64
+	if key != nil {
+		fee = policy.KeyregFee(account)
+		if fee > 0 {
+			if err := internal.CheckFee(account, fee); err != nil {
+				return types.Transaction{}, internal.Account{}, exitWith(ExitFailure, err)
+			}
+		}
+	}
+
+	params, err := internal.GetSuggestedParams(ctx, client)
+	if err != nil {
+		return types.Transaction{}, internal.Account{}, exitWith(ExitFailure, fmt.Errorf("failed to get the transaction parameters: %w", err))
+	}
+	txn, err := internal.MakeKeyreg(address, key, params, fee)
+	if err != nil {
+		return types.Transaction{}, internal.Account{}, exitWith(ExitFailure, fmt.Errorf("failed to build the key registration: %w", err))
+	}
+	return txn, account, nil
+}
+
+// exportKeyreg writes an unsigned online or offline key registration for the account of a key
+func exportKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, id string, offline bool, policy internal.FeePolicy, path string) error {
+	key, err := internal.ReadPartKey(ctx, client, id)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get participation key %s: %w", id, err))
+	}
+	// An offline registration only needs the account of the key
+	adj := "offline"
+	var online *api.ParticipationKey
+	if !offline {
+		adj = "online"
+		online = key
+	}
+	txn, account, err := buildKeyreg(ctx, client, online, key.Address, policy)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(path, internal.EncodeUnsigned(txn), 0600)
+	if err != nil {
+		return exitWith(ExitFailure, err)
+	}
+	_, err = fmt.Fprintf(out, "Wrote the %s key registration %s to %s\n"+
+		"It is valid from round %d to %d and must be signed by %s\n",
+		adj, crypto.GetTxID(txn), path, txn.FirstValid, txn.LastValid, internal.AuthorizingAddress(account))
+	return err
+}
+
+// exportMultisigKeyreg writes an online key registration for the key with id,
+// or an offline one for address when id is empty
+func exportMultisigKeyreg(
+	ctx context.Context,
+	client api.ClientWithResponsesInterface,
+	out io.Writer,
+	id string,
+	address string,
+	threshold int,
+	signers []string,
+	policy internal.FeePolicy,
+	path string,
+) error {
+	ma, err := internal.NewMultisig(threshold, signers)
+	if err != nil {
+		return exitWith(ExitUsage, err)
+	}
+	msig, err := ma.Address()
+	if err != nil {
+		return exitWith(ExitUsage, err)
+	}
+
+	var key *api.ParticipationKey
+	if id != "" {
+		key, err = internal.ReadPartKey(ctx, client, id)
+		if err != nil {
+			return exitWith(ExitFailure, fmt.Errorf("failed to get participation key %s: %w", id, err))
+		}
+		address = key.Address
+	}
+	txn, account, err := buildKeyreg(ctx, client, key, address, policy)
+	if err != nil {
+		return err
+	}
+	signer := internal.AuthorizingAddress(account)
+	if msig.String() != signer {
+		return exitWith(ExitUsage, fmt.Errorf("the multisig account %s can't sign for %s, it must be signed by %s", msig, address, signer))
+	}
+	data, err := internal.ExportMultisig(txn, ma)
+	if err != nil {
+		return exitWith(ExitFailure, err)
+	}
+	err = os.WriteFile(path, data, 0600)
+	if err != nil {
+		return exitWith(ExitFailure, err)
+	}
+	_, err = fmt.Fprintf(out, "Wrote the key registration %s to %s\n"+
+		"It is valid until round %d and needs %d of %d signatures from %s\n",
+		crypto.GetTxID(txn), path, txn.LastValid, threshold, len(signers), msig)
+	return err
+}
+
+// submitMultisigKeyreg merges the signatures of the files and submits the transaction
+func submitMultisigKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, paths []string) error {
+	parts := make([][]byte, len(paths))
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return exitWith(ExitUsage, err)
+		}
+		parts[i] = data
+	}
+	_, signed, err := internal.MergeMultisig(parts...)
+	if err != nil {
+		return exitWith(ExitUsage, fmt.Errorf("failed to merge the signatures of %s: %w", strings.Join(paths, ", "), err))
+	}
+	return broadcastKeyreg(ctx, client, out, signed)
+}
+
+// submitKeyreg broadcasts the signed key registration of a file
+func submitKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return exitWith(ExitUsage, err)
+	}
+	_, err = internal.DecodeSignedKeyreg(data)
+	if err != nil {
+		return exitWith(ExitUsage, fmt.Errorf("invalid key registration %s: %w", path, err))
+	}
+	return broadcastKeyreg(ctx, client, out, data)
+}
+
+// broadcastKeyreg submits a signed transaction and waits for it to be confirmed
+func broadcastKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, signed []byte) error {
+	txId, err := internal.SubmitTransaction(ctx, client, signed)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to submit the key registration: %w", err))
+	}
+	_, _ = fmt.Fprintf(out, "Submitted the key registration %s\n", txId)
+	round, err := internal.WaitForConfirmation(ctx, client, txId, internal.ConfirmationRounds)
+	if err != nil {
+		return exitWith(ExitFailure, err)
+	}
+	_, err = fmt.Fprintf(out, "Confirmed in round %d\n", round)
+	return err
+}
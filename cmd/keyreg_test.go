@@ -0,0 +1,341 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"github.com/algorandfoundation/algorun-tui/ui/modals/transaction"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func Test_ExportKeyreg(t *testing.T) {
+	ctx := context.Background()
+	address := fake.Address("export")
+	key := api.ParticipationKey{
+		Id:      "EXPORT",
+		Address: address,
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection-" + address),
+			VoteParticipationKey:      []byte("vote-" + address),
+			VoteFirstValid:            1000,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+	algod := fake.New(fake.WithRound(1000), fake.WithKeys(key))
+	defer algod.Close()
+	path := filepath.Join(t.TempDir(), "keyreg.txn")
+
+	var out bytes.Buffer
+	err := exportKeyreg(ctx, algod.Client(), &out, "EXPORT", false, internal.NeverFee, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "valid from round 1000 to 2000 and must be signed by "+address) {
+		t.Errorf("expected the validity and signer, got %s", out.String())
+	}
+	data, _ := os.ReadFile(path)
+	var stx types.SignedTxn
+	err = msgpack.Decode(data, &stx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !internal.IsOnlineKeyreg(stx.Txn) || stx.Txn.Sender.String() != address || stx.Txn.VoteLast != 2000 || stx.Txn.GenesisID != algod.GenesisId {
+		t.Errorf("unexpected online key registration %+v", stx.Txn)
+	}
+
+	err = exportKeyreg(ctx, algod.Client(), &out, "EXPORT", true, internal.NeverFee, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ = os.ReadFile(path)
+	var offline types.SignedTxn
+	_ = msgpack.Decode(data, &offline)
+	if internal.IsOnlineKeyreg(offline.Txn) || offline.Sig != (types.Signature{}) {
+		t.Errorf("expected an unsigned offline key registration, got %+v", offline)
+	}
+
+	var exitErr *ExitError
+	err = exportKeyreg(ctx, algod.Client(), &out, "MISSING", false, internal.NeverFee, path)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+		t.Errorf("expected exit code %d for a missing key, got %v", ExitFailure, err)
+	}
+
+	// The incentive fee is refused until the account can pay it
+	err = exportKeyreg(ctx, algod.Client(), &out, "EXPORT", false, internal.AlwaysFee, path)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure || !strings.Contains(err.Error(), "incentive eligibility fee") {
+		t.Errorf("expected exit code %d for an account without the fee, got %v", ExitFailure, err)
+	}
+	algod.SetAccount(api.Account{Address: address, Amount: 3000000, MinBalance: 100000, Status: "Offline"})
+	err = exportKeyreg(ctx, algod.Client(), &out, "EXPORT", false, internal.AlwaysFee, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ = os.ReadFile(path)
+	var incentive types.SignedTxn
+	_ = msgpack.Decode(data, &incentive)
+	if incentive.Txn.Fee != internal.IncentiveFee {
+		t.Errorf("expected the incentive fee, got %d", incentive.Txn.Fee)
+	}
+}
+
+func Test_SubmitKeyreg(t *testing.T) {
+	ctx := context.Background()
+	signer := crypto.GenerateAccount()
+	address := signer.Address.String()
+	key := api.ParticipationKey{
+		Id:      "SUBMIT",
+		Address: address,
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection-" + address),
+			VoteParticipationKey:      []byte("vote-" + address),
+			VoteFirstValid:            1000,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+	algod := fake.New(fake.WithRound(1000), fake.WithKeys(key))
+	defer algod.Close()
+	client := algod.Client()
+	dir := t.TempDir()
+	unsigned := filepath.Join(dir, "keyreg.txn")
+	signed := filepath.Join(dir, "keyreg.stxn")
+
+	var out bytes.Buffer
+	err := exportKeyreg(ctx, client, &out, "SUBMIT", false, internal.NeverFee, unsigned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exitErr *ExitError
+	err = submitKeyreg(ctx, client, &out, unsigned)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d for an unsigned transaction, got %v", ExitUsage, err)
+	}
+
+	// Sign like goal clerk sign
+	data, _ := os.ReadFile(unsigned)
+	var stx types.SignedTxn
+	_ = msgpack.Decode(data, &stx)
+	_, signedTxn, err := crypto.SignTransaction(signer.PrivateKey, stx.Txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = os.WriteFile(signed, signedTxn, 0600)
+
+	out.Reset()
+	err = submitKeyreg(ctx, client, &out, signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Confirmed in round 1001") {
+		t.Errorf("expected the confirmation, got %s", out.String())
+	}
+	account, _ := algod.Account(address)
+	if account.Status != "Online" || account.Participation == nil {
+		t.Errorf("expected the key to be registered, got %+v", account)
+	}
+
+	// The node refuses the transaction once it expired
+	algod.Advance(internal.KeyregValidity)
+	err = submitKeyreg(ctx, client, &out, signed)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+		t.Errorf("expected exit code %d for an expired transaction, got %v", ExitFailure, err)
+	}
+}
+
+func Test_MultisigKeyreg(t *testing.T) {
+	ctx := context.Background()
+	signers := []crypto.Account{crypto.GenerateAccount(), crypto.GenerateAccount(), crypto.GenerateAccount()}
+	addresses := make([]string, len(signers))
+	for i, signer := range signers {
+		addresses[i] = signer.Address.String()
+	}
+	ma, err := internal.NewMultisig(2, addresses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msig, _ := ma.Address()
+
+	// The account was rekeyed to the multisig account
+	address := fake.Address("multisig")
+	authAddr := msig.String()
+	key := api.ParticipationKey{
+		Id:      "MSIG",
+		Address: address,
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection-" + address),
+			VoteParticipationKey:      []byte("vote-" + address),
+			VoteFirstValid:            1000,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+	algod := fake.New(
+		fake.WithRound(1000),
+		fake.WithAccounts(api.Account{Address: address, Amount: 1000000000, Status: "Offline", AuthAddr: &authAddr}),
+		fake.WithKeys(key),
+	)
+	defer algod.Close()
+	client := algod.Client()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "keyreg.msig")
+
+	var out bytes.Buffer
+	var exitErr *ExitError
+	err = exportMultisigKeyreg(ctx, client, &out, "MSIG", "", 2, addresses[:2], internal.NeverFee, path)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d for another multisig account, got %v", ExitUsage, err)
+	}
+
+	err = exportMultisigKeyreg(ctx, client, &out, "MSIG", "", 2, addresses, internal.NeverFee, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "needs 2 of 3 signatures from "+authAddr) {
+		t.Errorf("expected the signing instructions, got %s", out.String())
+	}
+
+	// Each signer signs a copy of the exported file
+	unsigned, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for i, signer := range signers[:2] {
+		_, signed, err := crypto.AppendMultisigTransaction(signer.PrivateKey, ma, unsigned)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, filepath.Join(dir, "signed"+string(rune('a'+i))))
+		err = os.WriteFile(paths[i], signed, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = submitMultisigKeyreg(ctx, client, &out, paths[:1])
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage || !strings.Contains(err.Error(), "1 of 3 signatures") {
+		t.Errorf("expected the missing signatures, got %v", err)
+	}
+
+	out.Reset()
+	err = submitMultisigKeyreg(ctx, client, &out, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), "Submitted the key registration ") {
+		t.Errorf("expected the transaction id, got %s", out.String())
+	}
+	account, _ := algod.Account(address)
+	if account.Status != "Online" || account.Participation == nil ||
+		!internal.IsParticipationKeyActive(key, *account.Participation) {
+		t.Errorf("expected the key to be registered, got %+v", account)
+	}
+
+	t.Run("Offline", func(t *testing.T) {
+		path := filepath.Join(dir, "offline.msig")
+		err := exportMultisigKeyreg(ctx, client, &out, "", address, 2, addresses, internal.NeverFee, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		unsigned, _ := os.ReadFile(path)
+		_, signed, _ := crypto.AppendMultisigTransaction(signers[0].PrivateKey, ma, unsigned)
+		_, signed, _ = crypto.AppendMultisigTransaction(signers[2].PrivateKey, ma, signed)
+		_ = os.WriteFile(path, signed, 0600)
+		err = submitMultisigKeyreg(ctx, client, &out, []string{path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		account, _ := algod.Account(address)
+		if account.Status != "Offline" || account.Participation != nil {
+			t.Errorf("expected the account to be offline, got %+v", account)
+		}
+	})
+}
+
+// multisigCommand reads the arguments of the keyreg multisig export command shown by a view,
+// its lines end with a backslash until the last one
+func multisigCommand(view string) []string {
+	var command []string
+	found := false
+	for _, line := range strings.Split(view, "\n") {
+		line = strings.TrimSpace(line)
+		if !found && !strings.HasPrefix(line, "algorun keyreg multisig export") {
+			continue
+		}
+		found = true
+		command = append(command, strings.Fields(strings.TrimSuffix(line, "\\"))...)
+		if !strings.HasSuffix(line, "\\") {
+			break
+		}
+	}
+	return command
+}
+
+func Test_MultisigNoticeParses(t *testing.T) {
+	address := fake.Address("rekeyed")
+	stateProof := []byte("state-proof")
+	key := api.ParticipationKey{
+		Id:      "NOTICE",
+		Address: address,
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection"),
+			VoteParticipationKey:      []byte("vote"),
+			StateProofKey:             &stateProof,
+			VoteFirstValid:            1000,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+	for _, offline := range []bool{false, true} {
+		state := &internal.StateModel{
+			Status:   internal.StatusModel{Network: "testnet-v1.0"},
+			Accounts: map[string]internal.Account{address: {Address: address, AuthAddr: fake.Address("multisig")}},
+		}
+		model := transaction.New(state)
+		model.Participation = &key
+		model.Active = offline
+		model.Link = &internal.ShortLinkResponse{Id: "1234"}
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 120, Height: 80})
+		model.UpdateState()
+
+		args := multisigCommand(ansi.Strip(model.View()))
+		if len(args) < 4 || strings.Join(args[:4], " ") != "algorun keyreg multisig export" {
+			t.Fatalf("expected the multisig export command, got %v", args)
+		}
+		cmd := keyregMultisigExportCmd
+		err := cmd.ParseFlags(args[4:])
+		if err == nil {
+			err = cmd.ValidateArgs(cmd.Flags().Args())
+		}
+		if err == nil {
+			err = cmd.ValidateRequiredFlags()
+		}
+		if err == nil {
+			err = cmd.ValidateFlagGroups()
+		}
+		if err != nil {
+			t.Errorf("expected %v to parse, got %v", args, err)
+		}
+		if offline != keyregOffline || (offline && keyregAddress != address) || (!offline && cmd.Flags().Arg(0) != key.Id) {
+			t.Errorf("expected the command to register %s offline=%t, got %v", address, offline, args)
+		}
+
+		keyregOffline, keyregAddress, keyregThreshold, keyregSigners = false, "", 0, nil
+		for _, name := range []string{"offline", "address", "threshold", "signer"} {
+			cmd.Flags().Lookup(name).Changed = false
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/algorandfoundation/algorun-tui/ui/utils"
+	"github.com/spf13/cobra"
+)
+
+// OutputFormat is the format used when printing results of non-interactive commands
+type OutputFormat string
+
+const (
+	TableOutput    OutputFormat = "table"
+	JSONOutput     OutputFormat = "json"
+	MarkdownOutput OutputFormat = "md"
+	CSVOutput      OutputFormat = "csv"
+)
+
+var (
+	keysOutput   string
+	keysAddress  string
+	keysRounds   int
+	keysDays     int
+	keysDilution int
+)
+
+// keysCmd is the parent command for participation key management
+var keysCmd = &cobra.Command{
+	Use:          "keys",
+	Short:        "Manage participation keys",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Manage participation keys without the TUI"),
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		switch OutputFormat(keysOutput) {
+		case TableOutput, JSONOutput:
+		default:
+			return exitWith(ExitUsage, fmt.Errorf("invalid output format: %s", keysOutput))
+		}
+		return requireAlgod()
+	},
+}
+
+// keysListCmd prints every participation key on the node
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the participation keys",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return listKeys(context.Background(), client, cmd.OutOrStdout(), OutputFormat(keysOutput), keysAddress)
+	},
+}
+
+// keysShowCmd prints a single participation key
+var keysShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show a participation key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return showKey(context.Background(), client, cmd.OutOrStdout(), OutputFormat(keysOutput), args[0])
+	},
+}
+
+// keysGenerateCmd creates a participation key and waits for it to be installed
+var keysGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate a participation key",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return generateKey(context.Background(), client, cmd.OutOrStdout(), OutputFormat(keysOutput), keysAddress, keysRounds, keysDays, keysDilution)
+	},
+}
+
+// keysDeleteCmd removes a participation key from the node
+var keysDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a participation key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		return deleteKey(context.Background(), client, cmd.OutOrStdout(), OutputFormat(keysOutput), args[0])
+	},
+}
+
+func init() {
+	keysCmd.PersistentFlags().StringVarP(&keysOutput, "output", "o", string(TableOutput), style.LightBlue("output format, one of table|json"))
+
+	keysListCmd.Flags().StringVar(&keysAddress, "address", "", style.LightBlue("only list keys for this address"))
+
+	keysGenerateCmd.Flags().StringVar(&keysAddress, "address", "", style.LightBlue("account address to generate the key for"))
+	keysGenerateCmd.Flags().IntVar(&keysRounds, "rounds", 0, style.LightBlue("number of rounds the key is valid for"))
+	keysGenerateCmd.Flags().IntVar(&keysDays, "days", 0, style.LightBlue("number of days the key is valid for"))
+	keysGenerateCmd.Flags().IntVar(&keysDilution, "dilution", 0, style.LightBlue("key dilution, defaults to the node's value"))
+	_ = keysGenerateCmd.MarkFlagRequired("address")
+	keysGenerateCmd.MarkFlagsMutuallyExclusive("rounds", "days")
+	keysGenerateCmd.MarkFlagsOneRequired("rounds", "days")
+
+	keysCmd.AddCommand(keysListCmd)
+	keysCmd.AddCommand(keysShowCmd)
+	keysCmd.AddCommand(keysGenerateCmd)
+	keysCmd.AddCommand(keysDeleteCmd)
+}
+
+// listKeys writes the participation keys, optionally filtered by address
+func listKeys(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, format OutputFormat, address string) error {
+	keys, err := internal.GetPartKeys(ctx, client)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get participation keys: %w", err))
+	}
+	result := make([]api.ParticipationKey, 0)
+	if keys != nil {
+		for _, key := range *keys {
+			if address == "" || key.Address == address {
+				result = append(result, key)
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+
+	if format == JSONOutput {
+		return writeJSON(out, result)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tADDRESS\tFIRST VALID\tLAST VALID\tDILUTION\tLAST VOTE\tLAST PROPOSAL")
+	for _, key := range result {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\n",
+			key.Id,
+			key.Address,
+			key.Key.VoteFirstValid,
+			key.Key.VoteLastValid,
+			key.Key.VoteKeyDilution,
+			utils.StrOrNA(key.LastVote),
+			utils.StrOrNA(key.LastBlockProposal),
+		)
+	}
+	return w.Flush()
+}
+
+// showKey writes a single participation key
+func showKey(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, format OutputFormat, id string) error {
+	key, err := internal.ReadPartKey(ctx, client, id)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get participation key %s: %w", id, err))
+	}
+	if format == JSONOutput {
+		return writeJSON(out, key)
+	}
+	return writeKey(out, key)
+}
+
+// generateKey creates a participation key for an address and writes the result
+func generateKey(
+	ctx context.Context,
+	client api.ClientWithResponsesInterface,
+	out io.Writer,
+	format OutputFormat,
+	address string,
+	rounds int,
+	days int,
+	dilution int,
+) error {
+	if !internal.ValidateAddress(address) {
+		return exitWith(ExitUsage, fmt.Errorf("invalid address: %s", address))
+	}
+	if rounds <= 0 && days <= 0 {
+		return exitWith(ExitUsage, errors.New("either --rounds or --days must be greater than zero"))
+	}
+
+	s, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get status: %w", err))
+	}
+	if s.StatusCode() != 200 {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get status: %s", s.Status()))
+	}
+	status := internal.StatusModel{}
+	status.Update(s.JSON200.LastRound, s.JSON200.CatchupTime, s.JSON200.Catchpoint, s.JSON200.UpgradeNodeVote)
+	if status.State != internal.StableState {
+		return exitWith(ExitFailure, errors.New("the node must be synced before generating keys"))
+	}
+
+	// Convert the duration to rounds using the average round time
+	if days > 0 {
+		bm, err := internal.GetBlockMetrics(ctx, client, status.LastRound, 100)
+		if err != nil {
+			return exitWith(ExitFailure, fmt.Errorf("failed to get round time: %w", err))
+		}
+		if bm.AvgTime == 0 {
+			return exitWith(ExitFailure, errors.New("not enough rounds to calculate the round time, use --rounds instead"))
+		}
+		rounds = int(time.Duration(days) * 24 * time.Hour / bm.AvgTime)
+	}
+
+	params := api.GenerateParticipationKeysParams{
+		Dilution: nil,
+		First:    int(status.LastRound),
+		Last:     int(status.LastRound) + rounds,
+	}
+	if dilution > 0 {
+		params.Dilution = &dilution
+	}
+
+	key, err := internal.GenerateKeyPair(ctx, client, address, &params)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to generate participation key: %w", err))
+	}
+	if format == JSONOutput {
+		return writeJSON(out, key)
+	}
+	return writeKey(out, key)
+}
+
+// deleteKey removes a participation key and writes a confirmation
+func deleteKey(ctx context.Context, client api.ClientWithResponsesInterface, out io.Writer, format OutputFormat, id string) error {
+	err := internal.DeletePartKey(ctx, client, id)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to delete participation key %s: %w", id, err))
+	}
+	if format == JSONOutput {
+		return writeJSON(out, map[string]string{"id": id})
+	}
+	_, err = fmt.Fprintf(out, "Deleted participation key %s\n", id)
+	return err
+}
+
+// writeKey prints the participation key details in a key/value table
+func writeKey(out io.Writer, key *api.ParticipationKey) error {
+	stateProofKey := ""
+	if key.Key.StateProofKey != nil {
+		stateProofKey = base64.RawURLEncoding.EncodeToString(*key.Key.StateProofKey)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Participation ID:\t%s\n", key.Id)
+	_, _ = fmt.Fprintf(w, "Account:\t%s\n", key.Address)
+	_, _ = fmt.Fprintf(w, "Selection Key:\t%s\n", base64.RawURLEncoding.EncodeToString(key.Key.SelectionParticipationKey))
+	_, _ = fmt.Fprintf(w, "Vote Key:\t%s\n", base64.RawURLEncoding.EncodeToString(key.Key.VoteParticipationKey))
+	_, _ = fmt.Fprintf(w, "State Proof Key:\t%s\n", stateProofKey)
+	_, _ = fmt.Fprintf(w, "Vote First Valid:\t%d\n", key.Key.VoteFirstValid)
+	_, _ = fmt.Fprintf(w, "Vote Last Valid:\t%d\n", key.Key.VoteLastValid)
+	_, _ = fmt.Fprintf(w, "Vote Key Dilution:\t%d\n", key.Key.VoteKeyDilution)
+	_, _ = fmt.Fprintf(w, "Effective First Valid:\t%s\n", utils.StrOrNA(key.EffectiveFirstValid))
+	_, _ = fmt.Fprintf(w, "Effective Last Valid:\t%s\n", utils.StrOrNA(key.EffectiveLastValid))
+	_, _ = fmt.Fprintf(w, "Last Vote:\t%s\n", utils.StrOrNA(key.LastVote))
+	_, _ = fmt.Fprintf(w, "Last Block Proposal:\t%s\n", utils.StrOrNA(key.LastBlockProposal))
+	_, _ = fmt.Fprintf(w, "Last State Proof:\t%s\n", utils.StrOrNA(key.LastStateProof))
+	return w.Flush()
+}
+
+// writeJSON prints any value as indented JSON
+func writeJSON(out io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
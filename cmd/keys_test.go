@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"github.com/spf13/viper"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ListKeys(t *testing.T) {
+	ctx := context.Background()
+	client := test.GetClient(false)
+
+	t.Run("Table", func(t *testing.T) {
+		var out bytes.Buffer
+		err := listKeys(ctx, client, &out, TableOutput, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), "LAST PROPOSAL") || !strings.Contains(out.String(), "1234") {
+			t.Errorf("expected a table of keys, got %s", out.String())
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		var out bytes.Buffer
+		err := listKeys(ctx, client, &out, JSONOutput, "ABC")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []api.ParticipationKey
+		err = json.Unmarshal(out.Bytes(), &keys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) == 0 || keys[0].Id != "123" {
+			t.Errorf("expected sorted keys, got %v", keys)
+		}
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		var out bytes.Buffer
+		err := listKeys(ctx, client, &out, JSONOutput, "DEF")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.TrimSpace(out.String()) != "[]" {
+			t.Errorf("expected no keys, got %s", out.String())
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		var out bytes.Buffer
+		err := listKeys(ctx, test.GetClient(true), &out, TableOutput, "")
+		var exitErr *ExitError
+		if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+			t.Errorf("expected exit code %d, got %v", ExitFailure, err)
+		}
+	})
+}
+
+func Test_ShowKey(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	err := showKey(ctx, test.GetClient(false), &out, TableOutput, "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Vote Key Dilution:") {
+		t.Errorf("expected key details, got %s", out.String())
+	}
+
+	out.Reset()
+	err = showKey(ctx, test.GetClient(false), &out, JSONOutput, "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var key api.ParticipationKey
+	err = json.Unmarshal(out.Bytes(), &key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Id != "123" {
+		t.Errorf("expected key 123, got %s", key.Id)
+	}
+
+	err = showKey(ctx, test.NewClient(false, true), &out, TableOutput, "unknown")
+	if err == nil {
+		t.Error("expected an error for a missing key")
+	}
+}
+
+func Test_GenerateKey(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	err := generateKey(ctx, test.GetClient(false), &out, TableOutput, "ABC", 100, 0, 0)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d for an invalid address, got %v", ExitUsage, err)
+	}
+
+	err = generateKey(ctx, test.GetClient(false), &out, TableOutput, "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE", 0, 0, 0)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d for an invalid range, got %v", ExitUsage, err)
+	}
+
+	t.Run("FakeAlgod", func(t *testing.T) {
+		algod := fake.New(fake.WithRound(1000))
+		defer algod.Close()
+		address := fake.Address("keys")
+
+		var out bytes.Buffer
+		err := generateKey(ctx, algod.Client(), &out, JSONOutput, address, 0, 1, 500)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var key api.ParticipationKey
+		err = json.Unmarshal(out.Bytes(), &key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// One day of rounds at the fake's block time
+		rounds := int(24 * time.Hour / algod.BlockTime)
+		if key.Address != address || key.Key.VoteLastValid != 1000+rounds || key.Key.VoteKeyDilution != 500 {
+			t.Errorf("unexpected key %+v", key)
+		}
+		if len(algod.Keys()) != 1 {
+			t.Errorf("expected the key to be installed, got %d keys", len(algod.Keys()))
+		}
+	})
+}
+
+func Test_DeleteKey(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	err := deleteKey(ctx, test.GetClient(false), &out, JSONOutput, "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"id": "123"`) {
+		t.Errorf("expected the deleted id, got %s", out.String())
+	}
+
+	err = deleteKey(ctx, test.NewClient(false, true), &out, TableOutput, "123")
+	if err == nil {
+		t.Error("expected an error when the node rejects the deletion")
+	}
+}
+
+func Test_ExecuteInvalidKeysCommand(t *testing.T) {
+	viper.Set("algod-endpoint", "")
+	keysOutput = "yaml"
+	err := keysCmd.PersistentPreRunE(keysListCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d for an invalid format, got %v", ExitUsage, err)
+	}
+	keysOutput = string(TableOutput)
+	err = keysCmd.PersistentPreRunE(keysListCmd, nil)
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d for a missing endpoint, got %v", ExitUsage, err)
+	}
+	clearViper()
+}
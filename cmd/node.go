@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	nodeDataDir string
+	nodeBinary  string
+	nodeTimeout time.Duration
+)
+
+// nodeCmd is the parent command for controlling a local algod process
+var nodeCmd = &cobra.Command{
+	Use:          "node",
+	Short:        "Control the local algod process",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Start, stop and restart algod for an ALGORAND_DATA directory"),
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		loadConfig()
+		if nodeDataDir == "" {
+			nodeDataDir = os.Getenv("ALGORAND_DATA")
+		}
+		if nodeDataDir == "" {
+			return exitWith(ExitUsage, errors.New("--datadir or ALGORAND_DATA is required"))
+		}
+		return nil
+	},
+}
+
+// nodeStartCmd launches algod and waits for the REST API
+var nodeStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start algod",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return startNode(cmd.Context(), getNode(), cmd.OutOrStdout(), nodeTimeout)
+	},
+}
+
+// nodeStopCmd terminates algod
+var nodeStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop algod",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return stopNode(cmd.Context(), getNode(), cmd.OutOrStdout())
+	},
+}
+
+// nodeRestartCmd stops algod when it is running and starts it again
+var nodeRestartCmd = &cobra.Command{
+	Use:   "restart",
+	Short: "Restart algod",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		node := getNode()
+		err := stopNode(cmd.Context(), node, cmd.OutOrStdout())
+		if err != nil && !errors.Is(err, internal.ErrNodeNotRunning) {
+			return err
+		}
+		return startNode(cmd.Context(), node, cmd.OutOrStdout(), nodeTimeout)
+	},
+}
+
+func init() {
+	nodeCmd.PersistentFlags().StringVarP(&nodeDataDir, "datadir", "d", "", style.LightBlue("algod data directory, defaults to ALGORAND_DATA"))
+	nodeCmd.PersistentFlags().StringVar(&nodeBinary, "algod-bin", "algod", style.LightBlue("path to the algod binary"))
+	nodeCmd.PersistentFlags().DurationVar(&nodeTimeout, "timeout", time.Minute, style.LightBlue("how long to wait for algod to start or stop"))
+	_ = viper.BindPFlag("algod-bin", nodeCmd.PersistentFlags().Lookup("algod-bin"))
+
+	nodeCmd.AddCommand(nodeStartCmd)
+	nodeCmd.AddCommand(nodeStopCmd)
+	nodeCmd.AddCommand(nodeRestartCmd)
+}
+
+// getNode builds the internal.Node from the flags and configuration
+func getNode() internal.Node {
+	return internal.Node{
+		Binary:      viper.GetString("algod-bin"),
+		DataDir:     nodeDataDir,
+		StopTimeout: nodeTimeout,
+	}
+}
+
+// startNode launches algod and waits for /v2/status to answer
+func startNode(ctx context.Context, node internal.Node, out io.Writer, timeout time.Duration) error {
+	pid, err := node.Start()
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to start algod: %w", err))
+	}
+	_, _ = fmt.Fprintf(out, "Started algod (pid %d), waiting for %s\n", pid, node.DataDir)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err = internal.WaitForStatus(ctx, getDataDirClient(node.DataDir), time.Millisecond*500)
+	if err != nil {
+		return exitWith(ExitFailure, err)
+	}
+	_, err = fmt.Fprintln(out, "algod is ready")
+	return err
+}
+
+// stopNode terminates algod and waits for the process to exit
+func stopNode(ctx context.Context, node internal.Node, out io.Writer) error {
+	err := node.Stop(ctx)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to stop algod: %w", err))
+	}
+	_, err = fmt.Fprintln(out, "Stopped algod")
+	return err
+}
+
+// getDataDirClient creates a client from the configuration or the files algod writes in the data directory
+func getDataDirClient(dataDir string) func() (api.ClientWithResponsesInterface, error) {
+	return func() (api.ClientWithResponsesInterface, error) {
+		if viper.GetString("algod-endpoint") == "" {
+			endpoint, err := os.ReadFile(filepath.Join(dataDir, "algod.net"))
+			if err != nil {
+				return nil, err
+			}
+			viper.Set("algod-endpoint", "http://"+replaceEndpointUrl(strings.TrimSpace(string(endpoint))))
+		}
+		if viper.GetString("algod-token") == "" {
+			token, err := os.ReadFile(filepath.Join(dataDir, "algod.admin.token"))
+			if err != nil {
+				return nil, err
+			}
+			viper.Set("algod-token", strings.TrimSpace(string(token)))
+		}
+		return getClient()
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/spf13/viper"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_NodeCommandRequiresDataDir(t *testing.T) {
+	t.Setenv("ALGORAND_DATA", "")
+	nodeDataDir = ""
+	err := nodeCmd.PersistentPreRunE(nodeStartCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitUsage {
+		t.Errorf("expected exit code %d, got %v", ExitUsage, err)
+	}
+
+	dir := t.TempDir()
+	t.Setenv("ALGORAND_DATA", dir)
+	err = nodeCmd.PersistentPreRunE(nodeStartCmd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeDataDir != dir {
+		t.Errorf("expected the data directory from ALGORAND_DATA, got %s", nodeDataDir)
+	}
+	nodeDataDir = ""
+}
+
+func Test_StartStopNodeErrors(t *testing.T) {
+	ctx := context.Background()
+	var out bytes.Buffer
+	node := internal.Node{Binary: "algod-does-not-exist", DataDir: t.TempDir()}
+
+	err := startNode(ctx, node, &out, time.Second)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+		t.Errorf("expected exit code %d for a missing binary, got %v", ExitFailure, err)
+	}
+
+	err = stopNode(ctx, node, &out)
+	if !errors.Is(err, internal.ErrNodeNotRunning) {
+		t.Errorf("expected ErrNodeNotRunning, got %v", err)
+	}
+}
+
+func Test_GetDataDirClient(t *testing.T) {
+	viper.Set("algod-endpoint", "")
+	viper.Set("algod-token", "")
+	defer clearViper()
+
+	dir := t.TempDir()
+	getDataClient := getDataDirClient(dir)
+	_, err := getDataClient()
+	if err == nil {
+		t.Error("expected an error before algod writes algod.net")
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "algod.net"), []byte("[::]:4190\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(dir, "algod.admin.token"), []byte("token\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = getDataClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if viper.GetString("algod-endpoint") != "http://127.0.0.1:4190" {
+		t.Errorf("unexpected endpoint %s", viper.GetString("algod-endpoint"))
+	}
+	if viper.GetString("algod-token") != "token" {
+		t.Errorf("unexpected token %s", viper.GetString("algod-token"))
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reportSince  string
+	reportFormat string
+)
+
+// reportCmd summarises the uptime and participation recorded in the history store
+var reportCmd = &cobra.Command{
+	Use:          "report",
+	Short:        "Report uptime and participation",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Summarise the node availability and account participation of a period"),
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch OutputFormat(reportFormat) {
+		case MarkdownOutput, CSVOutput, JSONOutput:
+		default:
+			return exitWith(ExitUsage, fmt.Errorf("invalid report format: %s", reportFormat))
+		}
+		since, err := parseSince(reportSince)
+		if err != nil {
+			return exitWith(ExitUsage, err)
+		}
+		err = requireAlgod()
+		if err != nil {
+			return err
+		}
+		store, err := getStore()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		if store == nil {
+			return exitWith(ExitUsage, errors.New("the history store is disabled, set the store configuration"))
+		}
+		client, err := getClient()
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		to := time.Now()
+		return writeReport(context.Background(), client, store, cmd.OutOrStdout(), OutputFormat(reportFormat), to.Add(-since), to)
+	},
+}
+
+func init() {
+	reportCmd.Flags().StringVar(&reportSince, "since", "30d", style.LightBlue("length of the period, in days (30d) or as a duration (12h)"))
+	reportCmd.Flags().StringVar(&reportFormat, "format", string(MarkdownOutput), style.LightBlue("report format, one of md|csv|json"))
+}
+
+// parseSince reads a number of days or a time.Duration
+func parseSince(since string) (time.Duration, error) {
+	var d time.Duration
+	var err error
+	if days, ok := strings.CutSuffix(since, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		d = time.Duration(n) * time.Hour * 24
+	} else {
+		d, err = time.ParseDuration(since)
+	}
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid period: %s", since)
+	}
+	return d, nil
+}
+
+// writeReport fetches the accounts of the node and summarises the records of the store between from and to
+func writeReport(ctx context.Context, client api.ClientWithResponsesInterface, store *internal.Store, out io.Writer, format OutputFormat, from time.Time, to time.Time) error {
+	state := internal.StateModel{
+		Status: internal.StatusModel{State: internal.StableState},
+		Client: client,
+	}
+	v, err := client.GetVersionWithResponse(ctx)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get version: %w", err))
+	}
+	if v.StatusCode() == 200 {
+		state.Status.Network = v.JSON200.GenesisId
+	}
+	s, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get status: %w", err))
+	}
+	if s.StatusCode() == 200 {
+		state.Status.LastRound = uint64(s.JSON200.LastRound)
+	}
+	state.ParticipationKeys, err = internal.GetPartKeys(ctx, client)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get participation keys: %w", err))
+	}
+	state.Accounts, err = internal.AccountsFromState(&state, new(internal.Clock), client)
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to get accounts: %w", err))
+	}
+
+	// Earlier records tell the state at the start of the period
+	records, err := store.Query(time.Time{}, to, internal.Filter{})
+	if err != nil {
+		return exitWith(ExitFailure, fmt.Errorf("failed to read the history store: %w", err))
+	}
+	report := internal.NewReport(state, records, from, to)
+	switch format {
+	case CSVOutput:
+		return report.WriteCSV(out)
+	case JSONOutput:
+		return writeJSON(out, report)
+	default:
+		return report.WriteMarkdown(out)
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ParseSince(t *testing.T) {
+	for since, expected := range map[string]time.Duration{
+		"30d": time.Hour * 24 * 30,
+		"12h": time.Hour * 12,
+	} {
+		d, err := parseSince(since)
+		if err != nil || d != expected {
+			t.Errorf("expected %s for %s, got %s (%v)", expected, since, d, err)
+		}
+	}
+	for _, since := range []string{"", "d", "-1d", "0h", "week"} {
+		if _, err := parseSince(since); err == nil {
+			t.Errorf("expected %q to be invalid", since)
+		}
+	}
+}
+
+func Test_WriteReport(t *testing.T) {
+	address := fake.Address("report")
+	algod := fake.New(
+		fake.WithRound(1000),
+		fake.WithKeys(api.ParticipationKey{
+			Address: address,
+			Id:      "report-key",
+			Key:     api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 100000, VoteKeyDilution: 100},
+		}),
+	)
+	defer algod.Close()
+
+	store, err := internal.OpenStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := time.Now()
+	from := to.Add(-time.Hour)
+	records := []internal.Record{
+		{Time: from.Add(time.Minute), Kind: internal.NodeDownRecord},
+		{Time: from.Add(time.Minute * 7), Kind: internal.NodeRecoveredRecord},
+		{Time: from.Add(time.Minute * 10), Kind: internal.ProposalRecord, Address: address, Round: 900},
+	}
+	// A round every minute until the end of the period
+	for minute := 11; minute < 60; minute++ {
+		records = append(records, internal.Record{Time: from.Add(time.Minute * time.Duration(minute)), Kind: internal.RoundRecord, Round: uint64(900 + minute)})
+	}
+	err = store.Append(records...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Markdown", func(t *testing.T) {
+		var out bytes.Buffer
+		err := writeReport(context.Background(), algod.Client(), store, &out, MarkdownOutput, from, to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), "| 90.00% | 6m0s | 0s | 1 | 0 |") || !strings.Contains(out.String(), "| "+address+" |") {
+			t.Errorf("unexpected report %s", out.String())
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		var out bytes.Buffer
+		err := writeReport(context.Background(), algod.Client(), store, &out, JSONOutput, from, to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var report internal.Report
+		err = json.Unmarshal(out.Bytes(), &report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(report.Accounts) != 1 || report.Accounts[0].BlocksProposed != 1 || report.Round < 1000 {
+			t.Errorf("unexpected report %+v", report)
+		}
+	})
+	t.Run("CSV", func(t *testing.T) {
+		var out bytes.Buffer
+		err := writeReport(context.Background(), algod.Client(), store, &out, CSVOutput, from, to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(out.String(), "from,to,network") || !strings.Contains(out.String(), address) {
+			t.Errorf("unexpected csv %s", out.String())
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		algod.SetDown(true)
+		defer algod.SetDown(false)
+		var out bytes.Buffer
+		err := writeReport(context.Background(), algod.Client(), store, &out, MarkdownOutput, from, to)
+		var exitErr *ExitError
+		if !errors.As(err, &exitErr) || exitErr.Code != ExitFailure {
+			t.Errorf("expected a failure, got %v", err)
+		}
+	})
+}
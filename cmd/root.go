@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
@@ -17,7 +18,10 @@ import (
 	"github.com/spf13/viper"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 )
 
 const BANNER = `
@@ -40,6 +44,7 @@ var (
 		CompletionOptions: cobra.CompletionOptions{
 			DisableDefaultCmd: true,
 		},
+		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			log.SetOutput(cmd.OutOrStdout())
 			initConfig()
@@ -52,6 +57,16 @@ var (
 				return fmt.Errorf(style.Red.Render("algod-token is required"))
 			}
 
+			feePolicy, err := internal.ParseFeePolicy(viper.GetString("incentive-fee"))
+			if err != nil {
+				return errors.New(style.Red.Render(err.Error()))
+			}
+
+			networks, err := getNetworks()
+			if err != nil {
+				return errors.New(style.Red.Render(err.Error()))
+			}
+
 			client, err := getClient()
 			cobra.CheckErr(err)
 
@@ -91,18 +106,41 @@ var (
 					TPS:       0,
 					RX:        0,
 					TX:        0,
+					History:   internal.NewMetricsHistory(viper.GetInt("history")),
 				},
 				ParticipationKeys: partkeys,
 
+				FeePolicy:    feePolicy,
+				ShortLinkURL: viper.GetString("shortlink-url"),
+				Networks:     networks,
+
 				Client:  client,
 				Http:    new(internal.HttpPkg),
 				Context: ctx,
 			}
+			// Allow the TUI to control algod when the data directory is known
+			if algorandData := os.Getenv("ALGORAND_DATA"); algorandData != "" {
+				state.Node = &internal.Node{
+					Binary:  viper.GetString("algod-bin"),
+					DataDir: algorandData,
+				}
+			}
 			state.Accounts, err = internal.AccountsFromState(&state, new(internal.Clock), client)
 			cobra.CheckErr(err)
+			// Restore the proposals counted by earlier sessions
+			recorder := getRecorder()
+			if recorder != nil {
+				records, err := recorder.Store.Query(time.Now().Add(-storeRetention), time.Now(), internal.Filter{
+					Kinds: []internal.RecordKind{internal.ProposalRecord},
+				})
+				if err == nil {
+					state.Proposals = internal.ProposalsFromRecords(records)
+				}
+			}
 			// Fetch current state
 			err = state.Status.Fetch(ctx, client, state.Http)
 			cobra.CheckErr(err)
+			state.Signer = getDevSigner(state.Network())
 
 			m, err := ui.NewViewportViewModel(&state, client)
 			cobra.CheckErr(err)
@@ -112,16 +150,28 @@ var (
 				tea.WithAltScreen(),
 				tea.WithFPS(120),
 			)
+			// Stop watching when the TUI exits
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			watcher := internal.NewWatcher(&state, client)
+			watcher.Recorder = recorder
+			watcher.StallFactor = viper.GetInt("stall-factor")
+			watcher.Reference = getReference(ctx, state.Status.GenesisHash)
+			updates, _ := watcher.Subscribe(16)
 			go func() {
-				state.Watch(func(status *internal.StateModel, err error) {
-					if err == nil {
-						p.Send(state)
+				_ = watcher.Run(ctx)
+			}()
+			go func() {
+				for update := range updates {
+					p.Send(update.State)
+					if update.Err != nil {
+						p.Send(update.Err)
 					}
-					if err != nil {
-						p.Send(state)
-						p.Send(err)
+					// Events follow the state they describe
+					for _, event := range update.Events {
+						p.Send(event)
 					}
-				}, ctx, client)
+				}
 			}()
 			_, err = p.Run()
 			return err
@@ -135,6 +185,79 @@ func check(err interface{}) {
 	}
 }
 
+// ExitError is returned by non-interactive commands to report a process exit code
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+const (
+	// ExitFailure is used when the node could not complete the request
+	ExitFailure = 1
+	// ExitUsage is used when the command arguments are invalid
+	ExitUsage = 2
+)
+
+// exitWith wraps an error with an exit code
+func exitWith(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ExitError{Code: code, Err: err}
+}
+
+// wrapFailures applies exitOnFailure once
+var wrapFailures sync.Once
+
+// ExitCode is the process exit code of an error of Execute, ExitFailure without an ExitError
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return ExitFailure
+}
+
+// exitOnFailure reports the errors returned by the commands and their subcommands with ExitFailure,
+// so the errors cobra returns before running a command are left as usage errors
+func exitOnFailure(c *cobra.Command) {
+	if run := c.RunE; run != nil {
+		c.RunE = func(cmd *cobra.Command, args []string) error {
+			err := run(cmd, args)
+			if err != nil && ExitCode(err) == ExitFailure {
+				return exitWith(ExitFailure, err)
+			}
+			return err
+		}
+	}
+	for _, sub := range c.Commands() {
+		exitOnFailure(sub)
+	}
+}
+
+// requireAlgod loads the configuration and validates the algod connection settings
+func requireAlgod() error {
+	initConfig()
+	if viper.GetString("algod-endpoint") == "" {
+		return exitWith(ExitUsage, errors.New("algod-endpoint is required"))
+	}
+	if viper.GetString("algod-token") == "" {
+		return exitWith(ExitUsage, errors.New("algod-token is required"))
+	}
+	return nil
+}
+
 // Handle global flags and set usage templates
 func init() {
 	log.SetReportTimestamp(false)
@@ -155,6 +278,30 @@ func init() {
 	))
 	_ = viper.BindPFlag("algod-endpoint", rootCmd.PersistentFlags().Lookup("algod-endpoint"))
 	_ = viper.BindPFlag("algod-token", rootCmd.PersistentFlags().Lookup("algod-token"))
+	rootCmd.PersistentFlags().Int("history", internal.DefaultHistoryLength, style.LightBlue("number of samples kept for the metric charts"))
+	_ = viper.BindPFlag("history", rootCmd.PersistentFlags().Lookup("history"))
+	rootCmd.PersistentFlags().String("reference-endpoint", "", style.LightBlue("algod endpoint of the same network the sync progress is compared with, e.g. a public API"))
+	rootCmd.PersistentFlags().String("reference-token", "", style.LightBlue("API token of the reference endpoint"))
+	_ = viper.BindPFlag("reference-endpoint", rootCmd.PersistentFlags().Lookup("reference-endpoint"))
+	_ = viper.BindPFlag("reference-token", rootCmd.PersistentFlags().Lookup("reference-token"))
+	rootCmd.PersistentFlags().Int("stall-factor", internal.DefaultStallFactor, style.LightBlue("average round times without a round before the node is stalled"))
+	_ = viper.BindPFlag("stall-factor", rootCmd.PersistentFlags().Lookup("stall-factor"))
+
+	rootCmd.Flags().String("incentive-fee", string(internal.NeverFee), style.LightBlue("when online key registrations pay the 2 ALGO incentive eligibility fee, one of always|never|when-not-eligible"))
+	_ = viper.BindPFlag("incentive-fee", rootCmd.Flags().Lookup("incentive-fee"))
+
+	rootCmd.Flags().String("shortlink-url", internal.DefaultShortLinkURL, style.LightBlue("base URL of the short link service of the transaction links"))
+	_ = viper.BindPFlag("shortlink-url", rootCmd.Flags().Lookup("shortlink-url"))
+
+	// Dev signer of local networks
+	rootCmd.Flags().String("dev-mnemonic-file", "", style.LightBlue("sign key registrations of local networks with the mnemonics of a file"))
+	rootCmd.Flags().String("dev-kmd-url", "", style.LightBlue("sign key registrations of local networks with the keys of a kmd wallet"))
+	rootCmd.Flags().String("dev-kmd-token", strings.Repeat("a", 64), style.LightBlue("kmd API token"))
+	rootCmd.Flags().String("dev-kmd-wallet", "unencrypted-default-wallet", style.LightBlue("kmd wallet name"))
+	rootCmd.Flags().String("dev-kmd-password", "", style.LightBlue("kmd wallet password"))
+	for _, name := range []string{"dev-mnemonic-file", "dev-kmd-url", "dev-kmd-token", "dev-kmd-wallet", "dev-kmd-password"} {
+		_ = viper.BindPFlag(name, rootCmd.Flags().Lookup(name))
+	}
 
 	// Update Long Text
 	rootCmd.Long +=
@@ -168,11 +315,33 @@ func init() {
 
 	// Add Commands
 	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(keysCmd)
+	rootCmd.AddCommand(catchupCmd)
+	rootCmd.AddCommand(nodeCmd)
+	rootCmd.AddCommand(exporterCmd)
+	rootCmd.AddCommand(reportCmd)
+	rootCmd.AddCommand(keyregCmd)
+	rootCmd.AddCommand(shortlinkCmd)
+
+	// Invalid arguments and flags exit with ExitUsage
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return exitWith(ExitUsage, err)
+	})
 }
 
-// Execute executes the root command.
+// Execute executes the root command. The errors of the arguments, flags and required flags
+// checked by cobra are returned with ExitUsage, ExitCode gives the exit code of the others
 func Execute() error {
-	return rootCmd.Execute()
+	// The subcommands are added by the init of their files
+	wrapFailures.Do(func() {
+		exitOnFailure(rootCmd)
+	})
+	err := rootCmd.Execute()
+	var exitErr *ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return exitWith(ExitUsage, err)
+	}
+	return err
 }
 
 type AlgodConfig struct {
@@ -188,7 +357,9 @@ func replaceEndpointUrl(s string) string {
 func hasWildcardEndpointUrl(s string) bool {
 	return strings.Contains(s, "0.0.0.0") || strings.Contains(s, "::")
 }
-func initConfig() {
+
+// loadConfig reads the algorun configuration file and environment
+func loadConfig() {
 	// Find home directory.
 	home, err := os.UserHomeDir()
 	cobra.CheckErr(err)
@@ -203,9 +374,16 @@ func initConfig() {
 	viper.SetConfigName(".algorun")
 	viper.SetEnvPrefix("algorun")
 
+	// The history store is disabled with an empty path
+	viper.SetDefault("store", filepath.Join(home, ".algorun", "history"))
+
 	// Load Configurations
 	viper.AutomaticEnv()
 	_ = viper.ReadInConfig()
+}
+
+func initConfig() {
+	loadConfig()
 
 	// Check for algod
 	loadedAlgod := viper.GetString("algod-endpoint")
@@ -276,6 +454,93 @@ func initConfig() {
 
 }
 
+// storeRetention is how long the history store keeps records
+const storeRetention = time.Hour * 24 * 90
+
+// getRecorder opens the history store from the configuration, nil when it is disabled or unavailable
+func getRecorder() *internal.Recorder {
+	store, err := getStore()
+	if err != nil {
+		log.Warn("history store is unavailable", "err", err)
+		return nil
+	}
+	if store == nil {
+		return nil
+	}
+	_ = store.Prune(time.Now().Add(-storeRetention))
+	return internal.NewRecorder(store)
+}
+
+// getStore opens the history store, it is nil when the store is disabled
+func getStore() (*internal.Store, error) {
+	dir := viper.GetString("store")
+	if dir == "" {
+		return nil, nil
+	}
+	return internal.OpenStore(dir)
+}
+
+// getReference connects to the reference node of the configuration, it is nil without one
+// or when it is unavailable or on another network
+func getReference(ctx context.Context, genesisHash string) api.ClientWithResponsesInterface {
+	endpoint := viper.GetString("reference-endpoint")
+	if endpoint == "" {
+		return nil
+	}
+	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", viper.GetString("reference-token"))
+	if err != nil {
+		log.Warn("reference node is unavailable", "err", err)
+		return nil
+	}
+	client, err := api.NewClientWithResponses(endpoint, api.WithRequestEditorFn(apiToken.Intercept))
+	if err == nil {
+		err = internal.CheckReference(ctx, client, genesisHash)
+	}
+	if err != nil {
+		log.Warn("reference node is unavailable", "err", err)
+		return nil
+	}
+	return client
+}
+
+// getNetworks adds the networks of the configuration to the registry
+func getNetworks() (internal.NetworkRegistry, error) {
+	var custom []internal.Network
+	err := viper.UnmarshalKey("networks", &custom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid networks: %w", err)
+	}
+	return internal.NewNetworkRegistry(custom)
+}
+
+// getDevSigner loads the keys of the dev signer, it is nil without keys or on a network that is not local
+func getDevSigner(network internal.Network) *internal.DevSigner {
+	mnemonicFile := viper.GetString("dev-mnemonic-file")
+	kmdUrl := viper.GetString("dev-kmd-url")
+	if mnemonicFile == "" && kmdUrl == "" {
+		return nil
+	}
+	if !network.Local {
+		log.Warn("the dev signer only works on local networks", "network", network.Name)
+		return nil
+	}
+	var (
+		signer *internal.DevSigner
+		err    error
+	)
+	if mnemonicFile != "" {
+		signer, err = internal.LoadMnemonicSigner(mnemonicFile)
+	} else {
+		signer, err = internal.LoadKmdSigner(kmdUrl, viper.GetString("dev-kmd-token"),
+			viper.GetString("dev-kmd-wallet"), viper.GetString("dev-kmd-password"))
+	}
+	if err != nil {
+		log.Warn("dev signer is unavailable", "err", err)
+		return nil
+	}
+	return signer
+}
+
 func getClient() (*api.ClientWithResponses, error) {
 	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", viper.GetString("algod-token"))
 	if err != nil {
@@ -1,9 +1,16 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
 	"errors"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"github.com/spf13/viper"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -13,6 +20,35 @@ func clearViper() {
 	viper.Set("ALGORAND_DATA", "")
 }
 
+func Test_ExitCodes(t *testing.T) {
+	clearViper()
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	defer func() {
+		rootCmd.SetArgs(nil)
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+	}()
+	for _, tc := range []struct {
+		args []string
+		code int
+	}{
+		{[]string{"keys", "show"}, ExitUsage},
+		{[]string{"keys", "generate"}, ExitUsage},
+		{[]string{"keys", "list", "--unknown"}, ExitUsage},
+		{[]string{"missing"}, ExitUsage},
+	} {
+		rootCmd.SetArgs(tc.args)
+		if code := ExitCode(Execute()); code != tc.code {
+			t.Errorf("expected exit code %d for %v, got %d", tc.code, tc.args, code)
+		}
+	}
+	if ExitCode(nil) != 0 || ExitCode(errors.New("failure")) != ExitFailure {
+		t.Error("expected the exit code of errors without one to be ExitFailure")
+	}
+}
+
 // Test the stub root command
 func Test_ExecuteRootCommand(t *testing.T) {
 	clearViper()
@@ -117,3 +153,106 @@ func Test_ExecuteRootCommand(t *testing.T) {
 		check(errors.New("test"))
 	})
 }
+
+func Test_GetRecorder(t *testing.T) {
+	defer clearViper()
+	viper.Set("store", "")
+	if getRecorder() != nil {
+		t.Error("expected no recorder when the store is disabled")
+	}
+
+	dir := filepath.Join(t.TempDir(), "history")
+	viper.Set("store", dir)
+	recorder := getRecorder()
+	if recorder == nil || recorder.Store.Dir != dir {
+		t.Fatal("expected a recorder for the store")
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("expected the store directory to be created, got %v", err)
+	}
+}
+
+func Test_GetDevSigner(t *testing.T) {
+	defer func() {
+		viper.Set("dev-mnemonic-file", "")
+		viper.Set("dev-kmd-url", "")
+	}()
+	if getDevSigner(internal.DefaultNetworks.Find("", "tuinet-v1.0", false)) != nil {
+		t.Error("expected no signer without keys")
+	}
+
+	path := filepath.Join(t.TempDir(), "dev.mnemonic")
+	_ = os.WriteFile(path, []byte("artefact exist coil life turtle edge edge inside punch glance recycle teach melody diet method pause slam dumb race interest amused side learn able heavy\n"), 0600)
+	viper.Set("dev-mnemonic-file", path)
+	if getDevSigner(internal.DefaultNetworks.Find("", "mainnet-v1.0", false)) != nil {
+		t.Error("expected no signer on a public network")
+	}
+	signer := getDevSigner(internal.DefaultNetworks.Find("", "tuinet-v1.0", false))
+	if signer == nil || len(signer.Addresses()) != 1 {
+		t.Fatalf("expected the signer of the mnemonic file, got %v", signer)
+	}
+
+	account := crypto.GenerateAccount()
+	kmd := fake.NewKmd("", account)
+	defer kmd.Close()
+	viper.Set("dev-mnemonic-file", "")
+	viper.Set("dev-kmd-url", kmd.URL)
+	signer = getDevSigner(internal.DefaultNetworks.Find("", "dockernet-v1", false))
+	if signer == nil || signer.Addresses()[0] != account.Address.String() {
+		t.Errorf("expected the signer of the kmd wallet, got %v", signer)
+	}
+	viper.Set("dev-kmd-password", "wrong")
+	if getDevSigner(internal.DefaultNetworks.Find("", "dockernet-v1", false)) != nil {
+		t.Error("expected no signer when the wallet can't be opened")
+	}
+	viper.Set("dev-kmd-password", "")
+}
+
+func Test_GetNetworks(t *testing.T) {
+	defer viper.Set("networks", nil)
+	viper.Set("networks", []map[string]interface{}{{
+		"name":         "private",
+		"genesis-id":   "private-v1",
+		"explorer-url": "https://explorer.example.com/tx/{txid}",
+	}})
+	networks, err := getNetworks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	network := networks.Find("", "private-v1", false)
+	if network.Name != "private" || network.ExplorerURL != "https://explorer.example.com/tx/{txid}" {
+		t.Errorf("expected the network of the configuration, got %+v", network)
+	}
+
+	viper.Set("networks", []map[string]interface{}{{"name": "private"}})
+	_, err = getNetworks()
+	if err == nil {
+		t.Error("expected a network without a genesis to be refused")
+	}
+}
+
+func Test_GetReference(t *testing.T) {
+	ctx := context.Background()
+	algod := fake.New()
+	defer algod.Close()
+	v, err := algod.Client().GetVersionWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisHash := base64.StdEncoding.EncodeToString(v.JSON200.GenesisHashB64)
+	reference := fake.New()
+	reference.Token = ""
+	defer reference.Close()
+
+	defer viper.Set("reference-endpoint", "")
+	if getReference(ctx, genesisHash) != nil {
+		t.Error("expected no reference without an endpoint")
+	}
+	viper.Set("reference-endpoint", reference.URL)
+	if getReference(ctx, genesisHash) == nil {
+		t.Error("expected the reference of the configuration")
+	}
+	if getReference(ctx, "c3B1cmlvdXM=") != nil {
+		t.Error("expected a reference on another network to be refused")
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/spf13/cobra"
+)
+
+var shortlinkListen string
+
+// shortlinkCmd groups the commands of the short link service
+var shortlinkCmd = &cobra.Command{
+	Use:          "shortlink",
+	Short:        "Run a short link service",
+	Long:         style.Purple(BANNER) + "\n" + style.LightBlue("Run the short link service of the key registration links"),
+	SilenceUsage: true,
+}
+
+// shortlinkServeCmd serves the short link protocol of the TUI
+var shortlinkServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the short link protocol",
+	Long: style.LightBlue("Serve POST /online, POST /offline and GET /{id} like b.nodekit.run.\n" +
+		"Point the TUI at it with --shortlink-url, links are kept in memory."),
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listener, err := net.Listen("tcp", shortlinkListen)
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+		return exitWith(ExitFailure, serveShortLinks(ctx, listener, cmd.OutOrStdout()))
+	},
+}
+
+func init() {
+	shortlinkServeCmd.Flags().StringVar(&shortlinkListen, "listen", ":8090", style.LightBlue("address to serve the short links on"))
+	shortlinkCmd.AddCommand(shortlinkServeCmd)
+}
+
+// serveShortLinks serves a ShortLinkServer until the context is cancelled
+func serveShortLinks(ctx context.Context, listener net.Listener, out io.Writer) error {
+	server := &http.Server{Handler: internal.NewShortLinkServer().Handler(), ReadHeaderTimeout: time.Second * 5}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		_ = server.Shutdown(shutdown)
+	}()
+
+	fmt.Fprintf(out, "Serving short links on http://%s\n", listener.Addr())
+	err := server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
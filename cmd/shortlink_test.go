@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_ServeShortLinks(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- serveShortLinks(ctx, listener, io.Discard)
+	}()
+
+	base := "http://" + listener.Addr().String()
+	link, err := internal.GetOfflineShortLink(new(internal.HttpPkg), base, internal.OfflineShortLinkBody{
+		Account: fake.Address("shortlink"),
+		Network: "localnet",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link.Id == "" {
+		t.Error("expected a short link id")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("the short link service did not stop")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/ui"
 	"github.com/algorandfoundation/algorun-tui/ui/style"
@@ -26,35 +27,35 @@ var statusCmd = &cobra.Command{
 
 		// Get Algod from configuration
 		client, err := getClient()
-		cobra.CheckErr(err)
-		state := internal.StateModel{
-			Status: internal.StatusModel{
-				State:       "SYNCING",
-				Version:     "N/A",
-				Network:     "N/A",
-				Voting:      false,
-				NeedsUpdate: true,
-				LastRound:   0,
-			},
-			Metrics: internal.MetricsModel{
-				RoundTime: 0,
-				TPS:       0,
-				RX:        0,
-				TX:        0,
-			},
-			ParticipationKeys: nil,
+		if err != nil {
+			return exitWith(ExitFailure, err)
+		}
+		state, err := statusState(context.Background(), client, new(internal.HttpPkg))
+		if err != nil {
+			return exitWith(ExitFailure, err)
 		}
-		err = state.Status.Fetch(context.Background(), client, new(internal.HttpPkg))
-		cobra.CheckErr(err)
 		// Create the TUI
 		view := ui.MakeStatusViewModel(&state)
 
 		p := tea.NewProgram(view, tea.WithAltScreen())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		watcher := internal.NewWatcher(&state, client)
+		watcher.Recorder = getRecorder()
+		watcher.StallFactor = viper.GetInt("stall-factor")
+		watcher.Reference = getReference(ctx, state.Status.GenesisHash)
+		updates, _ := watcher.Subscribe(16)
+		go func() {
+			_ = watcher.Run(ctx)
+		}()
 		go func() {
-			state.Watch(func(status *internal.StateModel, err error) {
-				cobra.CheckErr(err)
-				p.Send(state)
-			}, context.Background(), client)
+			for update := range updates {
+				cobra.CheckErr(update.Err)
+				p.Send(update.State)
+				for _, event := range update.Events {
+					p.Send(event)
+				}
+			}
 		}()
 		// Execute the Command
 		if _, err := p.Run(); err != nil {
@@ -64,3 +65,30 @@ var statusCmd = &cobra.Command{
 		return nil
 	},
 }
+
+// statusState fetches the status of the node the status command starts with
+func statusState(ctx context.Context, client api.ClientWithResponsesInterface, httpPkg internal.HttpPkgInterface) (internal.StateModel, error) {
+	state := internal.StateModel{
+		Status: internal.StatusModel{
+			State:       "SYNCING",
+			Version:     "N/A",
+			Network:     "N/A",
+			Voting:      false,
+			NeedsUpdate: true,
+			LastRound:   0,
+		},
+		Metrics: internal.MetricsModel{
+			RoundTime: 0,
+			TPS:       0,
+			RX:        0,
+			TX:        0,
+			History:   internal.NewMetricsHistory(viper.GetInt("history")),
+		},
+		ParticipationKeys: nil,
+		Client:            client,
+		Http:              httpPkg,
+		Context:           ctx,
+	}
+	err := state.Status.Fetch(ctx, client, httpPkg)
+	return state, err
+}
@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"github.com/spf13/viper"
+	"io"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func Test_ExecuteInvalidStatusCommand(t *testing.T) {
@@ -14,15 +20,49 @@ func Test_ExecuteInvalidStatusCommand(t *testing.T) {
 	}
 }
 
-// Test the Status Command
+// releases answers the go-algorand release lookup without GitHub
+type releases struct {
+	internal.HttpPkgInterface
+}
+
+func (releases) Get(url string) (*http.Response, error) {
+	body := `[{"tag_name": "v3.26.0-stable"}]`
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body)))}, nil
+}
+
+// Test the state of the Status Command against the fake algod
 func Test_ExecuteStatusCommand(t *testing.T) {
-	// Smoke Test Command
-	viper.Set("algod-endpoint", "https://mainnet-api.4160.nodely.dev:443")
-	go func() {
-		err := statusCmd.RunE(nil, []string{"--algod-endpoint", "https://mainnet-api.4160.nodely.dev:443"})
-		if err != nil {
+	algod := fake.New(fake.WithRound(1000), fake.WithRoundTime(time.Millisecond*20))
+	defer algod.Close()
 
-		}
+	state, err := statusState(context.Background(), algod.Client(), new(releases))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Status.Network != algod.GenesisId || state.Status.Version == "N/A" {
+		t.Errorf("expected the status of the fake algod, got %+v", state.Status)
+	}
+
+	// The watcher of the command follows the rounds until it is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher := internal.NewWatcher(&state, algod.Client())
+	updates, _ := watcher.Subscribe(16)
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
 	}()
-	context.Background().Done()
+	deadline := time.After(time.Second * 5)
+	for round := uint64(0); round <= 1000; {
+		select {
+		case update := <-updates:
+			if update.Err != nil {
+				t.Fatal(update.Err)
+			}
+			round = update.State.Status.LastRound
+		case <-deadline:
+			t.Fatal("expected the watcher to follow the rounds")
+		}
+	}
+	cancel()
+	<-done
 }
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+)
+
+// AbsentFactor is how many expected proposal intervals an incentive eligible account
+// may go without proposing or sending a heartbeat before the protocol suspends it
+const AbsentFactor = 20
+
+// AtRiskRatio is the part of the allowed idle rounds after which an account is at risk
+const AtRiskRatio = 0.5
+
+// AbsenceRisk is how close an account is to being suspended for absenteeism
+type AbsenceRisk string
+
+const (
+	HealthyRisk         AbsenceRisk = "healthy"
+	AtRisk              AbsenceRisk = "at-risk"
+	LikelySuspendedRisk AbsenceRisk = "likely-suspended"
+)
+
+// AbsenceCheck compares the idle rounds of an account with the suspension threshold
+type AbsenceCheck struct {
+	Risk AbsenceRisk
+	// Idle is the number of rounds since the last proposal or heartbeat
+	Idle uint64
+	// Allowed is the number of idle rounds before the account is suspended
+	Allowed uint64
+	// Reason explains the risk in a few words
+	Reason string
+}
+
+// CheckAbsence applies the absenteeism rule to an online incentive eligible account,
+// it is nil for other accounts and while the stake share is unknown
+func CheckAbsence(account Account, lastRound uint64) *AbsenceCheck {
+	if account.Status != "Online" || !account.IncentiveEligible ||
+		account.ProposalEstimate == nil || account.ProposalEstimate.Share <= 0 {
+		return nil
+	}
+	lastSeen := uint64(max(account.LastProposed, account.LastHeartbeat))
+	if lastSeen == 0 {
+		return nil
+	}
+	// An account is expected to propose once every 1/share rounds
+	check := AbsenceCheck{
+		Risk:    HealthyRisk,
+		Allowed: uint64(math.Ceil(AbsentFactor / account.ProposalEstimate.Share)),
+	}
+	if lastRound > lastSeen {
+		check.Idle = lastRound - lastSeen
+	}
+	switch {
+	case check.Idle > check.Allowed:
+		check.Risk = LikelySuspendedRisk
+	case float64(check.Idle) > float64(check.Allowed)*AtRiskRatio:
+		check.Risk = AtRisk
+	}
+
+	seen := "heartbeat"
+	if account.LastProposed >= account.LastHeartbeat {
+		seen = "proposal"
+	}
+	check.Reason = fmt.Sprintf("no %s for %d of %d rounds", seen, check.Idle, check.Allowed)
+	return &check
+}
+
+// Severity orders the risks, from 0 for a healthy account
+func (r AbsenceRisk) Severity() int {
+	switch r {
+	case AtRisk:
+		return 1
+	case LikelySuspendedRisk:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,46 @@
+package internal
+
+import "testing"
+
+func Test_CheckAbsence(t *testing.T) {
+	// 1% of the online stake is expected to propose every 100 rounds
+	account := Account{
+		Status:            "Online",
+		IncentiveEligible: true,
+		LastProposed:      1000,
+		LastHeartbeat:     500,
+		ProposalEstimate:  &ProposalEstimate{Share: 0.01},
+	}
+	for _, tc := range []struct {
+		round  uint64
+		risk   AbsenceRisk
+		reason string
+	}{
+		{1500, HealthyRisk, "no proposal for 500 of 2000 rounds"},
+		{2001, AtRisk, "no proposal for 1001 of 2000 rounds"},
+		{3001, LikelySuspendedRisk, "no proposal for 2001 of 2000 rounds"},
+	} {
+		check := CheckAbsence(account, tc.round)
+		if check == nil || check.Risk != tc.risk || check.Reason != tc.reason || check.Allowed != 2000 {
+			t.Errorf("expected %s at round %d, got %+v", tc.risk, tc.round, check)
+		}
+	}
+
+	// A heartbeat resets the idle rounds
+	account.LastHeartbeat = 2900
+	check := CheckAbsence(account, 3001)
+	if check == nil || check.Risk != HealthyRisk || check.Idle != 101 || check.Reason != "no heartbeat for 101 of 2000 rounds" {
+		t.Errorf("expected a healthy account, got %+v", check)
+	}
+
+	for name, account := range map[string]Account{
+		"offline":      {Status: "Offline", IncentiveEligible: true, LastProposed: 1000, ProposalEstimate: &ProposalEstimate{Share: 0.01}},
+		"not eligible": {Status: "Online", LastProposed: 1000, ProposalEstimate: &ProposalEstimate{Share: 0.01}},
+		"no estimate":  {Status: "Online", IncentiveEligible: true, LastProposed: 1000},
+		"never seen":   {Status: "Online", IncentiveEligible: true, ProposalEstimate: &ProposalEstimate{Share: 0.01}},
+	} {
+		if check := CheckAbsence(account, 3001); check != nil {
+			t.Errorf("expected no check when %s, got %+v", name, check)
+		}
+	}
+}
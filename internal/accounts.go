@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/algorand/go-algorand-sdk/v2/types"
@@ -26,10 +27,34 @@ type Account struct {
 	// the balance should be tracked infrequently and use an appropriate distance from the
 	// LastModified value.
 	Balance int
+	// Amount is the exact balance in microAlgos, including the pending rewards
+	Amount int
+	// AmountWithoutPendingRewards is the balance in microAlgos before the pending rewards
+	AmountWithoutPendingRewards int
+	// PendingRewards are the microAlgos of rewards not yet added to the balance
+	PendingRewards int
+	// MinBalance is the balance in microAlgos the account has to keep for its assets, apps and boxes
+	MinBalance int
+	// AuthAddr is the address signing for the account when it was rekeyed, empty otherwise
+	AuthAddr string
+	// Assets and Apps count the assets and applications the account opted in to
+	Assets int
+	Apps   int
+	// CreatedAssets and CreatedApps count the assets and applications created by the account
+	CreatedAssets int
+	CreatedApps   int
 	// A count of how many participation Keys exist on this node for this Account
 	Keys int
 	// Expires is the date the participation key will expire
 	Expires *time.Time
+	// LastProposed is the last round proposed by the account, 0 when it never proposed
+	LastProposed int
+	// LastHeartbeat is the last round the account sent a heartbeat, 0 when it never did
+	LastHeartbeat int
+	// ProposalEstimate is the expected proposal rate, nil while the account is offline or the stake is unknown
+	ProposalEstimate *ProposalEstimate
+	// Absence is the suspension risk, nil unless the account is online and incentive eligible
+	Absence *AbsenceCheck
 }
 
 // GetAccount status of api.Account
@@ -107,6 +132,18 @@ func ParticipationKeysToAccounts(keys *[]api.ParticipationKey) map[string]Accoun
 func UpdateAccountFromRPC(account Account, rpcAccount api.Account) Account {
 	account.Status = rpcAccount.Status
 	account.Balance = rpcAccount.Amount / 1000000
+	account.Amount = rpcAccount.Amount
+	account.AmountWithoutPendingRewards = rpcAccount.AmountWithoutPendingRewards
+	account.PendingRewards = rpcAccount.PendingRewards
+	account.MinBalance = rpcAccount.MinBalance
+	account.AuthAddr = ""
+	if rpcAccount.AuthAddr != nil {
+		account.AuthAddr = *rpcAccount.AuthAddr
+	}
+	account.Assets = rpcAccount.TotalAssetsOptedIn
+	account.Apps = rpcAccount.TotalAppsOptedIn
+	account.CreatedAssets = rpcAccount.TotalCreatedAssets
+	account.CreatedApps = rpcAccount.TotalCreatedApps
 	account.Participation = rpcAccount.Participation
 
 	var incentiveEligible = false
@@ -118,6 +155,15 @@ func UpdateAccountFromRPC(account Account, rpcAccount api.Account) Account {
 
 	account.IncentiveEligible = incentiveEligible
 
+	account.LastProposed = 0
+	if rpcAccount.LastProposed != nil {
+		account.LastProposed = *rpcAccount.LastProposed
+	}
+	account.LastHeartbeat = 0
+	if rpcAccount.LastHeartbeat != nil {
+		account.LastHeartbeat = *rpcAccount.LastHeartbeat
+	}
+
 	return account
 }
 
@@ -131,6 +177,19 @@ func IsParticipationKeyActive(part api.ParticipationKey, account api.AccountPart
 	return equal
 }
 
+// FindActiveKey returns the local participation key registered online for the account, nil when it is not on this node
+func FindActiveKey(keys *[]api.ParticipationKey, account Account) *api.ParticipationKey {
+	if keys == nil || account.Participation == nil {
+		return nil
+	}
+	for i, key := range *keys {
+		if key.Address == account.Address && IsParticipationKeyActive(key, *account.Participation) {
+			return &(*keys)[i]
+		}
+	}
+	return nil
+}
+
 func UpdateAccountExpiredTime(t Time, account Account, state *StateModel) Account {
 	var nonResidentKey = true
 	for _, key := range *state.ParticipationKeys {
@@ -161,12 +220,27 @@ func AccountsFromState(state *StateModel, t Time, client api.ClientWithResponses
 			}
 			accounts[acct.Address] = UpdateAccountFromRPC(acct, rpcAcct)
 			accounts[acct.Address] = UpdateAccountExpiredTime(t, accounts[acct.Address], state)
+			account := accounts[acct.Address]
+			account.ProposalEstimate = EstimateProposals(account, state.Supply, state.Status.LastRound, state.Metrics.RoundTime)
+			account.Absence = CheckAbsence(account, state.Status.LastRound)
+			accounts[acct.Address] = account
 		}
 	}
 
 	return accounts, nil
 }
 
+// FormatAlgos writes microAlgos as ALGO without rounding
+func FormatAlgos(microAlgos int) string {
+	sign := ""
+	if microAlgos < 0 {
+		sign = "-"
+		microAlgos = -microAlgos
+	}
+	algos := fmt.Sprintf("%s%d.%06d", sign, microAlgos/1000000, microAlgos%1000000)
+	return strings.TrimSuffix(strings.TrimRight(algos, "0"), ".")
+}
+
 func ValidateAddress(address string) bool {
 	_, err := types.DecodeAddress(address)
 	if err != nil {
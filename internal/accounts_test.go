@@ -4,8 +4,8 @@ import (
 	"context"
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
-	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -14,11 +14,12 @@ import (
 func Test_AccountsFromState(t *testing.T) {
 
 	// Setup elevated client
-	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
-	if err != nil {
-		t.Fatal(err)
-	}
-	client, err := api.NewClientWithResponses("http://localhost:8080", api.WithRequestEditorFn(apiToken.Intercept))
+	algod := fake.New(fake.WithAccounts(
+		api.Account{Address: fake.Address("online"), Amount: 1000000, Status: "Online"},
+		api.Account{Address: fake.Address("offline"), Amount: 1000000, Status: "Offline"},
+	))
+	defer algod.Close()
+	client := algod.Client()
 
 	addresses, rewardsPool, feeSink, err := test.GetAddressesFromGenesis(context.Background(), client)
 
@@ -99,6 +100,7 @@ func Test_AccountsFromState(t *testing.T) {
 			Status:            acct.Status,
 			IncentiveEligible: true,
 			Balance:           acct.Amount / 1_000_000,
+			Amount:            acct.Amount,
 			Keys:              2,
 			Expires:           &expires,
 		},
@@ -113,3 +115,71 @@ func Test_AccountsFromState(t *testing.T) {
 	assert.Equal(t, expectedAccounts, accounts)
 
 }
+
+func Test_UpdateAccountFromRPC(t *testing.T) {
+	authAddr, lastProposed, lastHeartbeat := "DEF", 1200, 1300
+	account := UpdateAccountFromRPC(Account{Address: "ABC", Keys: 1}, api.Account{
+		Address:                     "ABC",
+		Amount:                      12_345_678,
+		AmountWithoutPendingRewards: 12_345_000,
+		PendingRewards:              678,
+		MinBalance:                  300_000,
+		AuthAddr:                    &authAddr,
+		LastProposed:                &lastProposed,
+		LastHeartbeat:               &lastHeartbeat,
+		Status:                      "Online",
+		TotalAssetsOptedIn:          2,
+		TotalAppsOptedIn:            3,
+		TotalCreatedAssets:          1,
+		TotalCreatedApps:            4,
+	})
+	expected := Account{
+		Address:                     "ABC",
+		Keys:                        1,
+		Status:                      "Online",
+		Balance:                     12,
+		Amount:                      12_345_678,
+		AmountWithoutPendingRewards: 12_345_000,
+		PendingRewards:              678,
+		MinBalance:                  300_000,
+		AuthAddr:                    "DEF",
+		Assets:                      2,
+		Apps:                        3,
+		CreatedAssets:               1,
+		CreatedApps:                 4,
+		LastProposed:                1200,
+		LastHeartbeat:               1300,
+	}
+	assert.Equal(t, expected, account)
+}
+
+func Test_FindActiveKey(t *testing.T) {
+	account := Account{Address: mock.Keys[0].Address, Participation: &api.AccountParticipation{
+		SelectionParticipationKey: mock.Keys[0].Key.SelectionParticipationKey,
+		VoteParticipationKey:      mock.Keys[0].Key.VoteParticipationKey,
+		VoteFirstValid:            mock.Keys[0].Key.VoteFirstValid,
+		VoteLastValid:             mock.Keys[0].Key.VoteLastValid,
+	}}
+	if key := FindActiveKey(&mock.Keys, account); key == nil || key.Id != mock.Keys[0].Id {
+		t.Errorf("expected the first key, got %+v", key)
+	}
+	account.Participation.VoteLastValid++
+	if key := FindActiveKey(&mock.Keys, account); key != nil {
+		t.Errorf("expected no local key, got %+v", key)
+	}
+	if key := FindActiveKey(nil, account); key != nil {
+		t.Errorf("expected no key without keys, got %+v", key)
+	}
+}
+
+func Test_FormatAlgos(t *testing.T) {
+	for microAlgos, expected := range map[int]string{
+		0:                     "0",
+		1:                     "0.000001",
+		10_500_000:            "10.5",
+		-2_000_000:            "-2",
+		9_007_199_254_740_993: "9007199254.740993",
+	} {
+		assert.Equal(t, expected, FormatAlgos(microAlgos))
+	}
+}
@@ -10,6 +10,8 @@ import (
 type BlockMetrics struct {
 	AvgTime time.Duration
 	TPS     float64
+	// LastTime is the timestamp of the last block, zero when it is unknown
+	LastTime time.Time
 }
 
 func GetBlockMetrics(ctx context.Context, client api.ClientWithResponsesInterface, round uint64, window int) (*BlockMetrics, error) {
@@ -46,6 +48,7 @@ func GetBlockMetrics(ctx context.Context, client api.ClientWithResponsesInterfac
 	if aTimestampRes == nil || bTimestampRes == nil {
 		return &avgs, nil
 	}
+	avgs.LastTime = time.Unix(int64(aTimestampRes.(float64)), 0)
 	aTimestamp := time.Duration(aTimestampRes.(float64)) * time.Second
 	bTimestamp := time.Duration(bTimestampRes.(float64)) * time.Second
 
@@ -60,3 +63,39 @@ func GetBlockMetrics(ctx context.Context, client api.ClientWithResponsesInterfac
 
 	return &avgs, nil
 }
+
+// Proposal is a block and the payout received by its proposer
+type Proposal struct {
+	Round    uint64 `json:"round"`
+	Proposer string `json:"proposer"`
+	// Payout is the proposer payout in microAlgos
+	Payout int       `json:"payout"`
+	Time   time.Time `json:"time"`
+}
+
+// GetBlockProposal reads the proposer (prp) and proposer payout (pp) of a block,
+// the proposer is empty before the consensus tracks it
+func GetBlockProposal(ctx context.Context, client api.ClientWithResponsesInterface, round uint64) (*Proposal, error) {
+	var format api.GetBlockParamsFormat = "json"
+	res, err := client.GetBlockWithResponse(ctx, int(round), &api.GetBlockParams{
+		Format: &format,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() != 200 {
+		return nil, errors.New(res.Status())
+	}
+
+	proposal := Proposal{Round: round}
+	if proposer, ok := res.JSON200.Block["prp"].(string); ok {
+		proposal.Proposer = proposer
+	}
+	if payout, ok := res.JSON200.Block["pp"].(float64); ok {
+		proposal.Payout = int(payout)
+	}
+	if timestamp, ok := res.JSON200.Block["ts"].(float64); ok {
+		proposal.Time = time.Unix(int64(timestamp), 0)
+	}
+	return &proposal, nil
+}
@@ -2,17 +2,18 @@ package internal
 
 import (
 	"context"
-	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"testing"
-	"time"
 )
 
 func Test_GetBlockMetrics(t *testing.T) {
 	window := 1000000
 
-	expectedAvg := time.Duration(2856041000)
+	algod := fake.New(fake.WithRound(42000000))
+	defer algod.Close()
+	client := algod.Client()
 
-	client, err := api.NewClientWithResponses("https://mainnet-api.4160.nodely.dev:443")
+	expectedAvg := algod.BlockTime
 
 	metrics, err := GetBlockMetrics(context.Background(), client, uint64(42000000), window)
 	if err != nil {
@@ -23,9 +24,15 @@ func Test_GetBlockMetrics(t *testing.T) {
 		t.Fatal("expected time to be", expectedAvg, "got", metrics.AvgTime)
 	}
 
-	expectedTPS := 25.318608871511294
+	expectedTPS := float64(algod.TxnsPerRound) / algod.BlockTime.Seconds()
 
 	if metrics.TPS != expectedTPS {
 		t.Fatal("expected tps to be", expectedTPS, "got", metrics.TPS)
 	}
+
+	// Blocks from the future are not available
+	_, err = GetBlockMetrics(context.Background(), client, uint64(42000001), window)
+	if err == nil {
+		t.Fatal("expected an error for a missing block")
+	}
 }
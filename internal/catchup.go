@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// catchpointRegex matches a catchpoint label, e.g. 4420000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA
+var catchpointRegex = regexp.MustCompile(`^[0-9]+#[A-Z2-7]{52}$`)
+
+// CatchpointModel is the progress of a fast catchup reported by algod.Status
+type CatchpointModel struct {
+	// Label is the catchpoint the node is catching up to
+	Label string
+
+	TotalAccounts     int
+	ProcessedAccounts int
+	VerifiedAccounts  int
+
+	TotalKvs     int
+	ProcessedKvs int
+	VerifiedKvs  int
+
+	TotalBlocks    int
+	AcquiredBlocks int
+
+	// StartedAt is when the catchup was first observed
+	StartedAt time.Time
+}
+
+// Update applies the catchpoint fields of a status response
+func (c *CatchpointModel) Update(status *NodeStatusResponse, now time.Time) {
+	if status == nil || status.Catchpoint == nil || *status.Catchpoint == "" {
+		*c = CatchpointModel{}
+		return
+	}
+	if c.Label != *status.Catchpoint || c.StartedAt.IsZero() {
+		c.StartedAt = now
+	}
+	c.Label = *status.Catchpoint
+	c.TotalAccounts = valueOrZero(status.CatchpointTotalAccounts)
+	c.ProcessedAccounts = valueOrZero(status.CatchpointProcessedAccounts)
+	c.VerifiedAccounts = valueOrZero(status.CatchpointVerifiedAccounts)
+	c.TotalKvs = valueOrZero(status.CatchpointTotalKvs)
+	c.ProcessedKvs = valueOrZero(status.CatchpointProcessedKvs)
+	c.VerifiedKvs = valueOrZero(status.CatchpointVerifiedKvs)
+	c.TotalBlocks = valueOrZero(status.CatchpointTotalBlocks)
+	c.AcquiredBlocks = valueOrZero(status.CatchpointAcquiredBlocks)
+}
+
+// Active is true while the node reports a catchpoint
+func (c CatchpointModel) Active() bool {
+	return c.Label != ""
+}
+
+// Progress is the overall completion of the catchup between 0 and 1.
+// Processing, verification and block acquisition are weighted equally.
+func (c CatchpointModel) Progress() float64 {
+	processed := ratio(c.ProcessedAccounts+c.ProcessedKvs, c.TotalAccounts+c.TotalKvs)
+	verified := ratio(c.VerifiedAccounts+c.VerifiedKvs, c.TotalAccounts+c.TotalKvs)
+	blocks := ratio(c.AcquiredBlocks, c.TotalBlocks)
+	return (processed + verified + blocks) / 3
+}
+
+// ETA estimates the time left from the progress made since StartedAt
+func (c CatchpointModel) ETA(now time.Time) (time.Duration, bool) {
+	progress := c.Progress()
+	if !c.Active() || c.StartedAt.IsZero() || progress <= 0 {
+		return 0, false
+	}
+	elapsed := now.Sub(c.StartedAt)
+	if elapsed <= 0 {
+		return 0, false
+	}
+	remaining := time.Duration(float64(elapsed) * (1 - progress) / progress)
+	return remaining.Round(time.Second), true
+}
+
+// IsValidCatchpoint checks the format of a catchpoint label
+func IsValidCatchpoint(catchpoint string) bool {
+	return catchpointRegex.MatchString(catchpoint)
+}
+
+// ReadCatchpointFile loads a catchpoint label from a local file
+func ReadCatchpointFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	catchpoint := strings.TrimSpace(string(data))
+	if !IsValidCatchpoint(catchpoint) {
+		return "", fmt.Errorf("invalid catchpoint in %s", path)
+	}
+	return catchpoint, nil
+}
+
+// StartCatchup asks algod to fast catchup to a catchpoint
+func StartCatchup(ctx context.Context, client api.ClientWithResponsesInterface, catchpoint string, min *int) (string, error) {
+	if !IsValidCatchpoint(catchpoint) {
+		return "", fmt.Errorf("invalid catchpoint: %s", catchpoint)
+	}
+	res, err := client.StartCatchupWithResponse(ctx, catchpoint, &api.StartCatchupParams{Min: min})
+	if err != nil {
+		return "", err
+	}
+	switch res.StatusCode() {
+	case 200:
+		return res.JSON200.CatchupMessage, nil
+	case 201:
+		return res.JSON201.CatchupMessage, nil
+	}
+	return "", responseError(res.Status(), res.JSON400, res.JSON401, res.JSON408, res.JSON500)
+}
+
+// AbortCatchup stops a running fast catchup
+func AbortCatchup(ctx context.Context, client api.ClientWithResponsesInterface, catchpoint string) (string, error) {
+	if !IsValidCatchpoint(catchpoint) {
+		return "", fmt.Errorf("invalid catchpoint: %s", catchpoint)
+	}
+	res, err := client.AbortCatchupWithResponse(ctx, catchpoint)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode() != 200 {
+		return "", responseError(res.Status(), res.JSON400, res.JSON401, res.JSON500)
+	}
+	return res.JSON200.CatchupMessage, nil
+}
+
+// responseError uses the first algod error message that is available
+func responseError(status string, responses ...*api.ErrorResponse) error {
+	for _, res := range responses {
+		if res != nil && res.Message != "" {
+			return errors.New(res.Message)
+		}
+	}
+	return errors.New(status)
+}
+
+func ratio(value int, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return min(1, float64(value)/float64(total))
+}
+
+func valueOrZero(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
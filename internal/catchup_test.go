@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"context"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testCatchpoint = "4420000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA"
+
+func Test_IsValidCatchpoint(t *testing.T) {
+	if !IsValidCatchpoint(testCatchpoint) {
+		t.Error("expected a valid catchpoint")
+	}
+	for _, invalid := range []string{"", "4420000", "#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA", "4420000#abc"} {
+		if IsValidCatchpoint(invalid) {
+			t.Errorf("expected %s to be invalid", invalid)
+		}
+	}
+}
+
+func Test_ReadCatchpointFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catchpoint.txt")
+	err := os.WriteFile(path, []byte(testCatchpoint+"\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	catchpoint, err := ReadCatchpointFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if catchpoint != testCatchpoint {
+		t.Errorf("expected %s, got %s", testCatchpoint, catchpoint)
+	}
+
+	err = os.WriteFile(path, []byte("invalid"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ReadCatchpointFile(path)
+	if err == nil {
+		t.Error("expected an invalid catchpoint error")
+	}
+
+	_, err = ReadCatchpointFile(filepath.Join(dir, "missing.txt"))
+	if err == nil {
+		t.Error("expected a missing file error")
+	}
+}
+
+func Test_CatchpointModel(t *testing.T) {
+	label := testCatchpoint
+	total := 100
+	half := 50
+	start := time.Unix(1000, 0)
+
+	var model CatchpointModel
+	model.Update(&NodeStatusResponse{
+		Catchpoint:                  &label,
+		CatchpointTotalAccounts:     &total,
+		CatchpointProcessedAccounts: &total,
+		CatchpointVerifiedAccounts:  &total,
+		CatchpointTotalBlocks:       &total,
+		CatchpointAcquiredBlocks:    &half,
+	}, start)
+
+	if !model.Active() {
+		t.Error("expected an active catchup")
+	}
+	if model.Progress() != float64(2.5)/3 {
+		t.Errorf("unexpected progress %f", model.Progress())
+	}
+	eta, ok := model.ETA(start.Add(50 * time.Second))
+	if !ok || eta != 10*time.Second {
+		t.Errorf("expected a 10s ETA, got %s", eta)
+	}
+
+	// A new status keeps the start time
+	model.Update(&NodeStatusResponse{Catchpoint: &label}, start.Add(time.Minute))
+	if !model.StartedAt.Equal(start) {
+		t.Error("expected the start time to be kept")
+	}
+	_, ok = model.ETA(start.Add(time.Minute))
+	if ok {
+		t.Error("expected no ETA without progress")
+	}
+
+	// Finished catchups are reset
+	model.Update(&NodeStatusResponse{}, start)
+	if model.Active() {
+		t.Error("expected the catchup to be inactive")
+	}
+}
+
+func Test_StartCatchup(t *testing.T) {
+	ctx := context.Background()
+	msg, err := StartCatchup(ctx, test.GetClient(false), testCatchpoint, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg != testCatchpoint {
+		t.Errorf("expected %s, got %s", testCatchpoint, msg)
+	}
+
+	_, err = StartCatchup(ctx, test.GetClient(false), "invalid", nil)
+	if err == nil {
+		t.Error("expected an invalid catchpoint error")
+	}
+
+	_, err = StartCatchup(ctx, test.NewClient(false, true), testCatchpoint, nil)
+	if err == nil || err.Error() != "catchup already in progress" {
+		t.Errorf("expected the algod error message, got %v", err)
+	}
+
+	_, err = StartCatchup(ctx, test.GetClient(true), testCatchpoint, nil)
+	if err == nil {
+		t.Error("expected a client error")
+	}
+}
+
+func Test_AbortCatchup(t *testing.T) {
+	ctx := context.Background()
+	msg, err := AbortCatchup(ctx, test.GetClient(false), testCatchpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg != testCatchpoint {
+		t.Errorf("expected %s, got %s", testCatchpoint, msg)
+	}
+
+	_, err = AbortCatchup(ctx, test.NewClient(false, true), testCatchpoint)
+	if err == nil {
+		t.Error("expected an error status")
+	}
+
+	_, err = AbortCatchup(ctx, test.GetClient(true), testCatchpoint)
+	if err == nil {
+		t.Error("expected a client error")
+	}
+}
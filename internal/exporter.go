@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// ExporterContentType is the Prometheus text exposition format
+const ExporterContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Exporter serves the latest Update from a Watcher as Prometheus metrics
+type Exporter struct {
+	mu     sync.RWMutex
+	update *Update
+}
+
+// Update replaces the state served by the exporter
+func (e *Exporter) Update(update Update) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.update = &update
+}
+
+// ServeHTTP writes the metrics for the latest Update
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.RLock()
+	update := e.update
+	e.mu.RUnlock()
+	w.Header().Set("Content-Type", ExporterContentType)
+	if update == nil {
+		// Nothing has been fetched yet
+		writeMetric(w, "algorun_up", "gauge", "Whether the last request to the node succeeded", series{value: 0})
+		return
+	}
+	_ = WriteMetrics(w, update.State, update.Err)
+}
+
+type series struct {
+	labels string
+	value  float64
+}
+
+// WriteMetrics writes the state in the Prometheus text exposition format
+func WriteMetrics(w io.Writer, state StateModel, err error) error {
+	var b strings.Builder
+	up := 1.0
+	if err != nil {
+		up = 0
+	}
+	writeMetric(&b, "algorun_up", "gauge", "Whether the last request to the node succeeded", series{value: up})
+	writeMetric(&b, "algorun_last_round", "gauge", "Last round reported by the node", series{value: float64(state.Status.LastRound)})
+	writeMetric(&b, "algorun_round_time_seconds", "gauge", "Average time between rounds", series{value: state.Metrics.RoundTime.Seconds()})
+	writeMetric(&b, "algorun_tps", "gauge", "Average transactions per second", series{value: state.Metrics.TPS})
+	writeMetric(&b, "algorun_rx_bytes_per_second", "gauge", "Bytes received per second by the node", series{value: float64(state.Metrics.RX)})
+	writeMetric(&b, "algorun_tx_bytes_per_second", "gauge", "Bytes sent per second by the node", series{value: float64(state.Metrics.TX)})
+	writeMetric(&b, "algorun_stalled", "gauge", "Whether the synced node stopped producing rounds", series{value: boolValue(state.Status.Health.Stalled)})
+	writeMetric(&b, "algorun_time_since_last_round_seconds", "gauge", "Time since the node produced the last round", series{value: state.Status.Health.TimeSinceLastRound.Seconds()})
+	writeMetric(&b, "algorun_clock_skew_seconds", "gauge", "Local clock minus the timestamp of the last block, when it was produced", series{value: state.Status.Health.ClockSkew.Seconds()})
+
+	addresses := make([]string, 0, len(state.Accounts))
+	for address := range state.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	var online, expires, nonResident, eligible, keys, lastVote, lastProposal, risk, idle []series
+	for _, address := range addresses {
+		account := state.Accounts[address]
+		labels := fmt.Sprintf(`address="%s"`, address)
+		online = append(online, series{labels, boolValue(account.Status == "Online")})
+		if account.Expires != nil {
+			expires = append(expires, series{labels, float64(account.Expires.Unix())})
+		}
+		nonResident = append(nonResident, series{labels, boolValue(account.NonResidentKey)})
+		eligible = append(eligible, series{labels, boolValue(account.IncentiveEligible)})
+		keys = append(keys, series{labels, float64(account.Keys)})
+
+		vote, proposal := lastKeyRounds(state.ParticipationKeys, address)
+		if vote > 0 {
+			lastVote = append(lastVote, series{labels, float64(vote)})
+		}
+		if proposal > 0 {
+			lastProposal = append(lastProposal, series{labels, float64(proposal)})
+		}
+		if account.Absence != nil {
+			risk = append(risk, series{labels, float64(account.Absence.Risk.Severity())})
+			idle = append(idle, series{labels, float64(account.Absence.Idle)})
+		}
+	}
+	writeMetric(&b, "algorun_account_online", "gauge", "Whether the account is registered online", online...)
+	writeMetric(&b, "algorun_account_key_expires_timestamp_seconds", "gauge", "Estimated expiry of the online participation key", expires...)
+	writeMetric(&b, "algorun_account_non_resident_key", "gauge", "Whether the online participation key is missing from this node", nonResident...)
+	writeMetric(&b, "algorun_account_incentive_eligible", "gauge", "Whether the account is eligible for block rewards", eligible...)
+	writeMetric(&b, "algorun_account_keys", "gauge", "Participation keys installed on this node", keys...)
+	writeMetric(&b, "algorun_account_last_vote_round", "gauge", "Last round a local key voted", lastVote...)
+	writeMetric(&b, "algorun_account_last_proposal_round", "gauge", "Last round a local key proposed a block", lastProposal...)
+	writeMetric(&b, "algorun_account_absence_risk", "gauge", "Suspension risk of an incentive eligible account, 0 healthy, 1 at risk, 2 likely suspended", risk...)
+	writeMetric(&b, "algorun_account_idle_rounds", "gauge", "Rounds since the last proposal or heartbeat of an incentive eligible account", idle...)
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// writeMetric writes the HELP and TYPE lines followed by the samples, skipping metrics without samples
+func writeMetric(w io.Writer, name string, kind string, help string, samples ...series) {
+	if len(samples) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	for _, s := range samples {
+		value := strconv.FormatFloat(s.value, 'f', -1, 64)
+		if s.labels != "" {
+			fmt.Fprintf(w, "%s{%s} %s\n", name, s.labels, value)
+		} else {
+			fmt.Fprintf(w, "%s %s\n", name, value)
+		}
+	}
+}
+
+// lastKeyRounds finds the latest vote and proposal rounds across the keys of an address
+func lastKeyRounds(keys *[]api.ParticipationKey, address string) (int, int) {
+	var vote, proposal int
+	if keys == nil {
+		return vote, proposal
+	}
+	for _, key := range *keys {
+		if key.Address != address {
+			continue
+		}
+		if key.LastVote != nil {
+			vote = max(vote, *key.LastVote)
+		}
+		if key.LastBlockProposal != nil {
+			proposal = max(proposal, *key.LastBlockProposal)
+		}
+	}
+	return vote, proposal
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
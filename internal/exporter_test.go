@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_WriteMetrics(t *testing.T) {
+	expires := time.Unix(1735689600, 0)
+	lastVote := 1330
+	lastProposal := 1200
+	olderVote := 900
+	keys := []api.ParticipationKey{
+		{Id: "1", Address: "ABC", LastVote: &olderVote},
+		{Id: "2", Address: "ABC", LastVote: &lastVote, LastBlockProposal: &lastProposal},
+		{Id: "3", Address: "DEF"},
+	}
+	state := StateModel{
+		Status: StatusModel{LastRound: 1337, Health: HealthModel{
+			Stalled: true, TimeSinceLastRound: time.Second * 15, ClockSkew: -time.Millisecond * 1500,
+		}},
+		Metrics: MetricsModel{RoundTime: time.Millisecond * 2800, TPS: 12.5, RX: 1024, TX: 2048},
+		Accounts: map[string]Account{
+			"DEF": {Address: "DEF", Status: "Offline", Keys: 1},
+			"ABC": {Address: "ABC", Status: "Online", Keys: 2, IncentiveEligible: true, Expires: &expires,
+				Absence: &AbsenceCheck{Risk: AtRisk, Idle: 1100, Allowed: 2000}},
+		},
+		ParticipationKeys: &keys,
+	}
+
+	var b bytes.Buffer
+	err := WriteMetrics(&b, state, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := b.String()
+	for _, line := range []string{
+		"# TYPE algorun_up gauge\nalgorun_up 1\n",
+		"algorun_last_round 1337\n",
+		"algorun_round_time_seconds 2.8\n",
+		"algorun_tps 12.5\n",
+		"algorun_rx_bytes_per_second 1024\n",
+		"algorun_tx_bytes_per_second 2048\n",
+		"# TYPE algorun_stalled gauge\nalgorun_stalled 1\n",
+		"algorun_time_since_last_round_seconds 15\n",
+		"algorun_clock_skew_seconds -1.5\n",
+		"algorun_account_online{address=\"ABC\"} 1\nalgorun_account_online{address=\"DEF\"} 0\n",
+		"# TYPE algorun_account_key_expires_timestamp_seconds gauge\nalgorun_account_key_expires_timestamp_seconds{address=\"ABC\"} 1735689600\n",
+		"algorun_account_non_resident_key{address=\"ABC\"} 0\n",
+		"algorun_account_incentive_eligible{address=\"ABC\"} 1\n",
+		"algorun_account_keys{address=\"ABC\"} 2\n",
+		"# TYPE algorun_account_last_vote_round gauge\nalgorun_account_last_vote_round{address=\"ABC\"} 1330\n# HELP",
+		"algorun_account_last_proposal_round{address=\"ABC\"} 1200\n",
+		"# TYPE algorun_account_absence_risk gauge\nalgorun_account_absence_risk{address=\"ABC\"} 1\n# HELP",
+		"algorun_account_idle_rounds{address=\"ABC\"} 1100\n",
+	} {
+		if !strings.Contains(output, line) {
+			t.Errorf("expected %q in:\n%s", line, output)
+		}
+	}
+
+	b.Reset()
+	err = WriteMetrics(&b, StateModel{}, errors.New("node down"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "algorun_up 0\n") {
+		t.Errorf("expected the exporter to be down, got %s", b.String())
+	}
+	if strings.Contains(b.String(), "algorun_account_") {
+		t.Errorf("expected no account metrics, got %s", b.String())
+	}
+}
+
+func Test_Exporter(t *testing.T) {
+	exporter := new(Exporter)
+	recorder := httptest.NewRecorder()
+	exporter.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if !strings.Contains(recorder.Body.String(), "algorun_up 0") {
+		t.Errorf("expected the exporter to be down before the first update, got %s", recorder.Body.String())
+	}
+
+	exporter.Update(Update{State: StateModel{Status: StatusModel{LastRound: 10}}})
+	recorder = httptest.NewRecorder()
+	exporter.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if recorder.Header().Get("Content-Type") != ExporterContentType {
+		t.Errorf("unexpected content type %s", recorder.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(recorder.Body.String(), "algorun_last_round 10") {
+		t.Errorf("expected the last round, got %s", recorder.Body.String())
+	}
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+)
+
+// IncentiveFee is the key registration fee in microAlgos that makes an account eligible for staking rewards
+const IncentiveFee = 2000000
+
+// FeePolicy decides when an online key registration pays the IncentiveFee
+type FeePolicy string
+
+const (
+	AlwaysFee          FeePolicy = "always"
+	NeverFee           FeePolicy = "never"
+	WhenNotEligibleFee FeePolicy = "when-not-eligible"
+)
+
+// FeePolicies lists the valid policies
+var FeePolicies = []FeePolicy{AlwaysFee, NeverFee, WhenNotEligibleFee}
+
+// ParseFeePolicy validates a policy from the configuration, empty is NeverFee
+func ParseFeePolicy(policy string) (FeePolicy, error) {
+	if policy == "" {
+		return NeverFee, nil
+	}
+	if !slices.Contains(FeePolicies, FeePolicy(policy)) {
+		return "", fmt.Errorf("invalid incentive fee policy %s, expected one of always|never|when-not-eligible", policy)
+	}
+	return FeePolicy(policy), nil
+}
+
+// KeyregFee is the fee in microAlgos of an online key registration of the account,
+// 0 when it pays the suggested fee
+func (p FeePolicy) KeyregFee(account Account) uint64 {
+	switch p {
+	case AlwaysFee:
+		return IncentiveFee
+	case WhenNotEligibleFee:
+		if !account.IncentiveEligible {
+			return IncentiveFee
+		}
+	}
+	return 0
+}
+
+// CheckFee explains why the account can't pay a fee without going under its MinBalance
+func CheckFee(account Account, fee uint64) error {
+	spendable := account.Amount - account.MinBalance
+	if int(fee) > spendable {
+		return fmt.Errorf("%s needs %s ALGO above its minimum balance of %s ALGO to pay the incentive eligibility fee, it has %s ALGO",
+			account.Address, FormatAlgos(int(fee)), FormatAlgos(account.MinBalance), FormatAlgos(max(0, spendable)))
+	}
+	return nil
+}
+
+// KeyregFee applies the FeePolicy to an online key registration of the account with address
+func (s *StateModel) KeyregFee(address string) uint64 {
+	return s.FeePolicy.KeyregFee(s.Accounts[address])
+}
+
+// CheckKeyregFee is the KeyregFee of the account with address, or why the account can't pay it
+func (s *StateModel) CheckKeyregFee(address string) (uint64, error) {
+	fee := s.KeyregFee(address)
+	if fee > 0 {
+		if err := CheckFee(s.Accounts[address], fee); err != nil {
+			return 0, err
+		}
+	}
+	return fee, nil
+}
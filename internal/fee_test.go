@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_ParseFeePolicy(t *testing.T) {
+	for value, expected := range map[string]FeePolicy{
+		"":                  NeverFee,
+		"never":             NeverFee,
+		"always":            AlwaysFee,
+		"when-not-eligible": WhenNotEligibleFee,
+	} {
+		policy, err := ParseFeePolicy(value)
+		if err != nil || policy != expected {
+			t.Errorf("expected %s for %q, got %s %v", expected, value, policy, err)
+		}
+	}
+	_, err := ParseFeePolicy("sometimes")
+	if err == nil {
+		t.Error("expected an invalid policy to fail")
+	}
+}
+
+func Test_KeyregFee(t *testing.T) {
+	eligible := Account{IncentiveEligible: true}
+	ineligible := Account{}
+	for _, c := range []struct {
+		policy  FeePolicy
+		account Account
+		fee     uint64
+	}{
+		{NeverFee, ineligible, 0},
+		{AlwaysFee, eligible, IncentiveFee},
+		{AlwaysFee, ineligible, IncentiveFee},
+		{WhenNotEligibleFee, eligible, 0},
+		{WhenNotEligibleFee, ineligible, IncentiveFee},
+	} {
+		if fee := c.policy.KeyregFee(c.account); fee != c.fee {
+			t.Errorf("expected a fee of %d with %s for %+v, got %d", c.fee, c.policy, c.account, fee)
+		}
+	}
+
+	state := StateModel{FeePolicy: WhenNotEligibleFee, Accounts: map[string]Account{"ABC": eligible}}
+	if state.KeyregFee("ABC") != 0 || state.KeyregFee("DEF") != IncentiveFee {
+		t.Error("expected the fee of the accounts of the state")
+	}
+}
+
+func Test_CheckFee(t *testing.T) {
+	account := Account{Address: "ABC", Amount: 2100000, MinBalance: 100000}
+	if err := CheckFee(account, IncentiveFee); err != nil {
+		t.Error(err)
+	}
+	account.Amount--
+	err := CheckFee(account, IncentiveFee)
+	if err == nil || !strings.Contains(err.Error(), "it has 1.999999 ALGO") {
+		t.Errorf("expected the balance to be too low, got %v", err)
+	}
+
+	state := StateModel{FeePolicy: AlwaysFee, Accounts: map[string]Account{"ABC": account}}
+	_, err = state.CheckKeyregFee("ABC")
+	if err == nil {
+		t.Error("expected the account to be unable to pay the fee")
+	}
+	state.FeePolicy = NeverFee
+	if fee, err := state.CheckKeyregFee("ABC"); fee != 0 || err != nil {
+		t.Errorf("expected the suggested fee, got %d and %v", fee, err)
+	}
+}
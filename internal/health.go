@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"time"
+)
+
+// DefaultStallFactor is the number of average round times without a round before a node is stalled
+const DefaultStallFactor = 5
+
+// FallbackRoundTime replaces the average round time until enough rounds are measured
+const FallbackRoundTime = time.Millisecond * 2800
+
+// MaxClockSkew is the difference between the local clock and the block timestamps tolerated,
+// block timestamps have a one second resolution and reach the node after the proposal
+const MaxClockSkew = time.Second * 10
+
+// HealthModel tells when a node still answering requests stopped following the network
+type HealthModel struct {
+	// TimeSinceLastRound is reported by algod.Status
+	TimeSinceLastRound time.Duration
+	// Stalled is true when no round was produced for the StallThreshold of a synced node
+	Stalled bool
+	// ClockSkew is how far the local clock is ahead of the timestamp of the last block, negative when behind
+	ClockSkew time.Duration
+}
+
+// StallThreshold is the time without a round before a node is stalled
+func StallThreshold(roundTime time.Duration, factor int) time.Duration {
+	if roundTime <= 0 {
+		roundTime = FallbackRoundTime
+	}
+	if factor <= 0 {
+		factor = DefaultStallFactor
+	}
+	return roundTime * time.Duration(factor)
+}
+
+// UpdateHealth applies the time since the last round of a status response, after Update
+func (m *StatusModel) UpdateHealth(status *NodeStatusResponse, roundTime time.Duration, factor int) {
+	if status == nil {
+		m.Health.TimeSinceLastRound = 0
+		m.Health.Stalled = false
+		return
+	}
+	m.Health.TimeSinceLastRound = time.Duration(status.TimeSinceLastRound)
+	// Dev mode networks only produce a round when a transaction is submitted
+	m.Health.Stalled = m.State == StableState && !m.DevMode &&
+		m.Health.TimeSinceLastRound > StallThreshold(roundTime, factor)
+}
+
+// CheckClock compares the local time with the timestamp of the last block, produced TimeSinceLastRound ago
+func (h *HealthModel) CheckClock(now time.Time, blockTime time.Time) {
+	if blockTime.IsZero() {
+		return
+	}
+	h.ClockSkew = now.Add(-h.TimeSinceLastRound).Sub(blockTime)
+}
+
+// Skewed is true when the ClockSkew is above MaxClockSkew either way
+func (h HealthModel) Skewed() bool {
+	return h.ClockSkew > MaxClockSkew || h.ClockSkew < -MaxClockSkew
+}
+
+// FormatSkew prints a clock skew to the second with its sign, e.g. +1m5s or -12s
+func FormatSkew(skew time.Duration) string {
+	skew = skew.Round(time.Second)
+	if skew >= 0 {
+		return "+" + skew.String()
+	}
+	return skew.String()
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_StallThreshold(t *testing.T) {
+	if threshold := StallThreshold(time.Second*3, 4); threshold != time.Second*12 {
+		t.Errorf("expected 12s, got %s", threshold)
+	}
+	if threshold := StallThreshold(0, 0); threshold != FallbackRoundTime*DefaultStallFactor {
+		t.Errorf("expected the fallback round time and default factor, got %s", threshold)
+	}
+}
+
+func Test_UpdateHealth(t *testing.T) {
+	status := StatusModel{State: StableState}
+	status.UpdateHealth(&NodeStatusResponse{TimeSinceLastRound: int(time.Second * 10)}, time.Second*3, 5)
+	if status.Health.Stalled || status.Health.TimeSinceLastRound != time.Second*10 {
+		t.Errorf("expected a healthy node, got %+v", status.Health)
+	}
+	status.UpdateHealth(&NodeStatusResponse{TimeSinceLastRound: int(time.Second * 16)}, time.Second*3, 5)
+	if !status.Health.Stalled {
+		t.Errorf("expected a stalled node, got %+v", status.Health)
+	}
+
+	// Rounds are expected to pause while syncing or in dev mode
+	status.State = SyncingState
+	status.UpdateHealth(&NodeStatusResponse{TimeSinceLastRound: int(time.Minute)}, time.Second*3, 5)
+	if status.Health.Stalled {
+		t.Error("expected a syncing node not to be stalled")
+	}
+	status = StatusModel{State: StableState, DevMode: true}
+	status.UpdateHealth(&NodeStatusResponse{TimeSinceLastRound: int(time.Minute)}, time.Second*3, 5)
+	if status.Health.Stalled {
+		t.Error("expected a dev mode node not to be stalled")
+	}
+}
+
+func Test_CheckClock(t *testing.T) {
+	now := time.Unix(1700000100, 0)
+	health := HealthModel{TimeSinceLastRound: time.Second * 2}
+	health.CheckClock(now, time.Unix(1700000097, 0))
+	if health.ClockSkew != time.Second || health.Skewed() {
+		t.Errorf("expected a 1s skew, got %s", health.ClockSkew)
+	}
+	health.CheckClock(now, time.Unix(1700000158, 0))
+	if health.ClockSkew != -time.Minute || !health.Skewed() {
+		t.Errorf("expected the clock to be a minute behind, got %s", health.ClockSkew)
+	}
+	// An unknown block time keeps the last skew
+	health.CheckClock(now, time.Time{})
+	if health.ClockSkew != -time.Minute {
+		t.Errorf("expected the skew to be kept, got %s", health.ClockSkew)
+	}
+	if FormatSkew(time.Second*65+time.Millisecond*400) != "+1m5s" || FormatSkew(-time.Second*12) != "-12s" {
+		t.Errorf("unexpected skew format %s %s", FormatSkew(time.Second*65), FormatSkew(-time.Second*12))
+	}
+}
@@ -0,0 +1,121 @@
+package internal
+
+// DefaultHistoryLength is the number of samples kept by a zero History
+const DefaultHistoryLength = 120
+
+// History is a fixed size ring buffer of samples, the oldest sample is dropped when it is full
+type History struct {
+	values []float64
+	start  int
+	size   int
+}
+
+// NewHistory creates an empty History holding up to length samples
+func NewHistory(length int) History {
+	if length <= 0 {
+		length = DefaultHistoryLength
+	}
+	return History{values: make([]float64, length)}
+}
+
+// Push adds a sample, replacing the oldest one when the History is full
+func (h *History) Push(value float64) {
+	if h.values == nil {
+		*h = NewHistory(DefaultHistoryLength)
+	}
+	end := (h.start + h.size) % len(h.values)
+	h.values[end] = value
+	if h.size < len(h.values) {
+		h.size++
+	} else {
+		h.start = (h.start + 1) % len(h.values)
+	}
+}
+
+// Len is the number of samples
+func (h History) Len() int {
+	return h.size
+}
+
+// Cap is the maximum number of samples
+func (h History) Cap() int {
+	return len(h.values)
+}
+
+// Values copies the samples from oldest to newest
+func (h History) Values() []float64 {
+	values := make([]float64, h.size)
+	for i := range values {
+		values[i] = h.values[(h.start+i)%len(h.values)]
+	}
+	return values
+}
+
+// Last is the newest sample
+func (h History) Last() (float64, bool) {
+	if h.size == 0 {
+		return 0, false
+	}
+	return h.values[(h.start+h.size-1)%len(h.values)], true
+}
+
+// Stats returns the minimum, average and maximum of the samples
+func (h History) Stats() (float64, float64, float64) {
+	values := h.Values()
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+	low, high, sum := values[0], values[0], 0.0
+	for _, value := range values {
+		low = min(low, value)
+		high = max(high, value)
+		sum += value
+	}
+	return low, sum / float64(len(values)), high
+}
+
+// Clone copies the History so it can be read while the original keeps changing
+func (h History) Clone() History {
+	if h.values != nil {
+		h.values = append([]float64{}, h.values...)
+	}
+	return h
+}
+
+// MetricsHistory keeps a History for each metric shown in the status
+type MetricsHistory struct {
+	// RoundTime is in seconds
+	RoundTime History
+	TPS       History
+	// RX and TX are in bytes per second
+	RX History
+	TX History
+}
+
+// NewMetricsHistory creates the histories with the same length
+func NewMetricsHistory(length int) MetricsHistory {
+	return MetricsHistory{
+		RoundTime: NewHistory(length),
+		TPS:       NewHistory(length),
+		RX:        NewHistory(length),
+		TX:        NewHistory(length),
+	}
+}
+
+// Push records the current values of the MetricsModel
+func (h *MetricsHistory) Push(metrics MetricsModel) {
+	h.RoundTime.Push(metrics.RoundTime.Seconds())
+	h.TPS.Push(metrics.TPS)
+	h.RX.Push(float64(metrics.RX))
+	h.TX.Push(float64(metrics.TX))
+}
+
+// Clone copies every History
+func (h MetricsHistory) Clone() MetricsHistory {
+	return MetricsHistory{
+		RoundTime: h.RoundTime.Clone(),
+		TPS:       h.TPS.Clone(),
+		RX:        h.RX.Clone(),
+		TX:        h.TX.Clone(),
+	}
+}
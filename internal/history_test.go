@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func Test_History(t *testing.T) {
+	history := NewHistory(3)
+	if _, ok := history.Last(); ok {
+		t.Error("expected no samples")
+	}
+	for _, value := range []float64{1, 2, 3, 4, 5} {
+		history.Push(value)
+	}
+	if history.Len() != 3 || history.Cap() != 3 {
+		t.Errorf("expected 3 of 3 samples, got %d of %d", history.Len(), history.Cap())
+	}
+	if values := history.Values(); !slices.Equal(values, []float64{3, 4, 5}) {
+		t.Errorf("expected the newest samples oldest first, got %v", values)
+	}
+	if last, _ := history.Last(); last != 5 {
+		t.Errorf("expected 5, got %v", last)
+	}
+	low, avg, high := history.Stats()
+	if low != 3 || avg != 4 || high != 5 {
+		t.Errorf("expected 3/4/5, got %v/%v/%v", low, avg, high)
+	}
+
+	clone := history.Clone()
+	history.Push(6)
+	if values := clone.Values(); !slices.Equal(values, []float64{3, 4, 5}) {
+		t.Errorf("expected the clone to be unchanged, got %v", values)
+	}
+
+	// The zero value uses the default length
+	var empty History
+	empty.Push(1)
+	if empty.Cap() != DefaultHistoryLength || empty.Len() != 1 {
+		t.Errorf("expected the default length, got %d", empty.Cap())
+	}
+	if NewHistory(0).Cap() != DefaultHistoryLength {
+		t.Error("expected the default length for an invalid length")
+	}
+}
+
+func Test_MetricsHistory(t *testing.T) {
+	history := NewMetricsHistory(10)
+	history.Push(MetricsModel{RoundTime: time.Millisecond * 2500, TPS: 10, RX: 100, TX: 200})
+	history.Push(MetricsModel{RoundTime: time.Millisecond * 3500, TPS: 20, RX: 300, TX: 400})
+
+	if values := history.RoundTime.Values(); !slices.Equal(values, []float64{2.5, 3.5}) {
+		t.Errorf("expected round times in seconds, got %v", values)
+	}
+	if last, _ := history.TX.Last(); last != 400 {
+		t.Errorf("expected 400, got %v", last)
+	}
+
+	state := StateModel{Metrics: MetricsModel{History: history}}
+	snapshot := state.Snapshot()
+	state.Metrics.History.Push(MetricsModel{})
+	if snapshot.Metrics.History.TPS.Len() != 2 {
+		t.Error("expected the snapshot history to be unchanged")
+	}
+}
@@ -3,17 +3,24 @@ package internal
 import (
 	"io"
 	"net/http"
+	"time"
 )
 
+// HttpTimeout bounds the requests to external services, so a hanging service fails instead of blocking its caller
+const HttpTimeout = time.Second * 5
+
+// httpClient is the client of HttpPkg
+var httpClient = &http.Client{Timeout: HttpTimeout}
+
 type HttpPkg struct {
 	HttpPkgInterface
 }
 
 func (HttpPkg) Get(url string) (resp *http.Response, err error) {
-	return http.Get(url)
+	return httpClient.Get(url)
 }
 func (HttpPkg) Post(url string, contentType string, body io.Reader) (resp *http.Response, err error) {
-	return http.Post(url, contentType, body)
+	return httpClient.Post(url, contentType, body)
 }
 
 var Http HttpPkg
@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// KeyregValidity is the number of rounds a key registration stays valid after it is built
+const KeyregValidity = 1000
+
+// ConfirmationRounds is how many rounds to wait for a submitted transaction to be confirmed
+const ConfirmationRounds = 10
+
+// GetSuggestedParams reads the fees and the genesis of the network, the transaction
+// is valid from the last round of the node for KeyregValidity rounds
+func GetSuggestedParams(ctx context.Context, client api.ClientWithResponsesInterface) (types.SuggestedParams, error) {
+	res, err := client.TransactionParamsWithResponse(ctx)
+	if err != nil {
+		return types.SuggestedParams{}, err
+	}
+	if res.StatusCode() != 200 {
+		return types.SuggestedParams{}, errors.New(res.Status())
+	}
+	return types.SuggestedParams{
+		Fee:              types.MicroAlgos(res.JSON200.Fee),
+		GenesisID:        res.JSON200.GenesisId,
+		GenesisHash:      res.JSON200.GenesisHash,
+		FirstRoundValid:  types.Round(res.JSON200.LastRound),
+		LastRoundValid:   types.Round(res.JSON200.LastRound + KeyregValidity),
+		ConsensusVersion: res.JSON200.ConsensusVersion,
+		MinFee:           uint64(res.JSON200.MinFee),
+	}, nil
+}
+
+// MakeKeyreg builds an unsigned key registration, online with the participation key
+// or offline for the address when the key is nil. A fee above the suggested one replaces it
+func MakeKeyreg(address string, key *api.ParticipationKey, params types.SuggestedParams, fee uint64) (types.Transaction, error) {
+	sender, err := types.DecodeAddress(address)
+	if err != nil {
+		return types.Transaction{}, err
+	}
+	if len(params.GenesisHash) == 0 {
+		return types.Transaction{}, errors.New("key registration must contain a genesis hash")
+	}
+	txn := types.Transaction{
+		Type: types.KeyRegistrationTx,
+		Header: types.Header{
+			Sender:     sender,
+			FirstValid: params.FirstRoundValid,
+			LastValid:  params.LastRoundValid,
+			GenesisID:  params.GenesisID,
+		},
+	}
+	copy(txn.GenesisHash[:], params.GenesisHash)
+	if key != nil {
+		if key.Address != address {
+			return types.Transaction{}, fmt.Errorf("participation key %s belongs to %s", key.Id, key.Address)
+		}
+		copy(txn.VotePK[:], key.Key.VoteParticipationKey)
+		copy(txn.SelectionPK[:], key.Key.SelectionParticipationKey)
+		if key.Key.StateProofKey != nil {
+			copy(txn.StateProofPK[:], *key.Key.StateProofKey)
+		}
+		txn.VoteFirst = types.Round(key.Key.VoteFirstValid)
+		txn.VoteLast = types.Round(key.Key.VoteLastValid)
+		txn.VoteKeyDilution = uint64(key.Key.VoteKeyDilution)
+	}
+
+	// The fee is per byte unless it is below the minimum or the requested fee
+	size, err := transaction.EstimateSize(txn)
+	if err != nil {
+		return types.Transaction{}, err
+	}
+	txn.Fee = types.MicroAlgos(max(params.MinFee, uint64(params.Fee)*size, fee))
+	return txn, nil
+}
+
+// IsOnlineKeyreg is true when the transaction registers a participation key
+func IsOnlineKeyreg(txn types.Transaction) bool {
+	return txn.Type == types.KeyRegistrationTx && txn.VotePK != types.VotePK{}
+}
+
+// MatchKeyreg checks that the transaction is sent by the account of the participation key and
+// registers the key, or takes the account offline
+func MatchKeyreg(txn types.Transaction, key api.ParticipationKey, offline bool) error {
+	if txn.Sender.String() != key.Address {
+		return fmt.Errorf("the transaction is sent by %s, not %s", txn.Sender.String(), key.Address)
+	}
+	if offline {
+		if IsOnlineKeyreg(txn) {
+			return errors.New("expected an offline key registration")
+		}
+		return nil
+	}
+	if !bytes.Equal(txn.VotePK[:], key.Key.VoteParticipationKey) ||
+		txn.VoteFirst != types.Round(key.Key.VoteFirstValid) || txn.VoteLast != types.Round(key.Key.VoteLastValid) {
+		return fmt.Errorf("the transaction doesn't register participation key %s", key.Id)
+	}
+	return nil
+}
+
+// EncodeUnsigned writes the transaction like goal clerk send -o, as a msgpack
+// signed transaction without signature that goal clerk sign and hardware wallets accept
+func EncodeUnsigned(txn types.Transaction) []byte {
+	return msgpack.Encode(types.SignedTxn{Txn: txn})
+}
+
+// SubmitTransaction broadcasts msgpack encoded signed transactions and returns the id of the first one
+func SubmitTransaction(ctx context.Context, client api.ClientWithResponsesInterface, signed []byte) (string, error) {
+	res, err := client.RawTransactionWithBodyWithResponse(ctx, "application/x-binary", bytes.NewReader(signed))
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode() != 200 {
+		if res.JSON400 != nil {
+			return "", errors.New(res.JSON400.Message)
+		}
+		return "", errors.New(res.Status())
+	}
+	return res.JSON200.TxId, nil
+}
+
+// DecodeSignedKeyreg reads a signed key registration file, as written by goal clerk sign
+func DecodeSignedKeyreg(data []byte) (types.SignedTxn, error) {
+	var stx types.SignedTxn
+	err := msgpack.Decode(data, &stx)
+	if err != nil {
+		return stx, fmt.Errorf("not a signed transaction: %w", err)
+	}
+	if stx.Txn.Type != types.KeyRegistrationTx {
+		return stx, fmt.Errorf("expected a key registration, got a %s transaction", stx.Txn.Type)
+	}
+	if stx.Sig == (types.Signature{}) && stx.Msig.Blank() && stx.Lsig.Blank() {
+		return stx, errors.New("the transaction is not signed")
+	}
+	return stx, nil
+}
+
+// WaitForConfirmation checks a pending transaction after every block and returns the round
+// it was confirmed in. It fails when the pool drops the transaction or after rounds blocks.
+func WaitForConfirmation(ctx context.Context, client api.ClientWithResponsesInterface, txId string, rounds int) (int, error) {
+	status, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if status.StatusCode() != 200 {
+		return 0, errors.New(status.Status())
+	}
+	round := status.JSON200.LastRound
+	var format api.PendingTransactionInformationParamsFormat = "json"
+	for waited := 0; ; waited++ {
+		pending, err := client.PendingTransactionInformationWithResponse(ctx, txId, &api.PendingTransactionInformationParams{
+			Format: &format,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if pending.StatusCode() != 200 {
+			return 0, errors.New(pending.Status())
+		}
+		if pending.JSON200.ConfirmedRound != nil && *pending.JSON200.ConfirmedRound > 0 {
+			return *pending.JSON200.ConfirmedRound, nil
+		}
+		if pending.JSON200.PoolError != "" {
+			return 0, fmt.Errorf("transaction rejected: %s", pending.JSON200.PoolError)
+		}
+		if waited >= rounds {
+			return 0, fmt.Errorf("transaction %s not confirmed after %d rounds", txId, rounds)
+		}
+
+		next, err := client.WaitForBlockWithResponse(ctx, round)
+		if err != nil {
+			return 0, err
+		}
+		if next.StatusCode() != 200 {
+			return 0, errors.New(next.Status())
+		}
+		round = next.JSON200.LastRound
+	}
+}
@@ -0,0 +1,204 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_GetSuggestedParams(t *testing.T) {
+	params, err := GetSuggestedParams(context.Background(), test.GetClient(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.FirstRoundValid != 1337 || params.LastRoundValid != 1337+KeyregValidity || params.MinFee != 1000 {
+		t.Errorf("unexpected params %+v", params)
+	}
+
+	_, err = GetSuggestedParams(context.Background(), test.NewClient(false, true))
+	if err == nil {
+		t.Error("expected an error for an unauthorized client")
+	}
+	_, err = GetSuggestedParams(context.Background(), test.GetClient(true))
+	if err == nil {
+		t.Error("expected an error for a failing client")
+	}
+}
+
+func Test_MakeKeyreg(t *testing.T) {
+	address := "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE"
+	params := types.SuggestedParams{
+		GenesisID:       "tuinet-v1.0",
+		GenesisHash:     bytes.Repeat([]byte{1}, 32),
+		FirstRoundValid: 100,
+		LastRoundValid:  1100,
+		MinFee:          1000,
+	}
+	key := api.ParticipationKey{
+		Id:      "123",
+		Address: address,
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: bytes.Repeat([]byte{2}, 32),
+			VoteParticipationKey:      bytes.Repeat([]byte{3}, 32),
+			VoteFirstValid:            100,
+			VoteLastValid:             3000000,
+			VoteKeyDilution:           1733,
+		},
+	}
+
+	txn, err := MakeKeyreg(address, &key, params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsOnlineKeyreg(txn) || txn.Sender.String() != address || txn.VoteLast != 3000000 || txn.VoteKeyDilution != 1733 {
+		t.Errorf("unexpected online key registration %+v", txn)
+	}
+	if txn.Fee != 1000 || txn.LastValid != 1100 || txn.GenesisHash[0] != 1 {
+		t.Errorf("unexpected header %+v", txn.Header)
+	}
+	txn, err = MakeKeyreg(address, &key, params, IncentiveFee)
+	if err != nil || txn.Fee != IncentiveFee {
+		t.Errorf("expected the incentive fee, got %d and %v", txn.Fee, err)
+	}
+
+	// The fee is per byte when the network is congested
+	params.Fee = 10
+	txn, err = MakeKeyreg(address, nil, params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IsOnlineKeyreg(txn) || txn.Fee <= 1000 {
+		t.Errorf("expected an offline key registration with a per byte fee, got %+v", txn)
+	}
+
+	_, err = MakeKeyreg("ABC", nil, params, 0)
+	if err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+	key.Address = "ABC"
+	_, err = MakeKeyreg(address, &key, params, 0)
+	if err == nil {
+		t.Error("expected an error for the key of another account")
+	}
+	params.GenesisHash = nil
+	_, err = MakeKeyreg(address, nil, params, 0)
+	if err == nil {
+		t.Error("expected an error without the genesis hash")
+	}
+}
+
+func Test_MatchKeyreg(t *testing.T) {
+	address := "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE"
+	params := types.SuggestedParams{GenesisHash: bytes.Repeat([]byte{1}, 32), MinFee: 1000}
+	key := api.ParticipationKey{
+		Id:      "123",
+		Address: address,
+		Key: api.AccountParticipation{
+			VoteParticipationKey: bytes.Repeat([]byte{3}, 32),
+			VoteFirstValid:       100,
+			VoteLastValid:        3000000,
+		},
+	}
+	online, _ := MakeKeyreg(address, &key, params, 0)
+	offline, _ := MakeKeyreg(address, nil, params, 0)
+
+	if err := MatchKeyreg(online, key, false); err != nil {
+		t.Errorf("expected the key to match, got %v", err)
+	}
+	if err := MatchKeyreg(offline, key, true); err != nil {
+		t.Errorf("expected the offline registration to match, got %v", err)
+	}
+	if err := MatchKeyreg(online, key, true); err == nil {
+		t.Error("expected an online registration to be refused for an offline one")
+	}
+	if err := MatchKeyreg(offline, key, false); err == nil {
+		t.Error("expected an offline registration to be refused for the key")
+	}
+	other := key
+	other.Key.VoteLastValid = 2000000
+	if err := MatchKeyreg(online, other, false); err == nil {
+		t.Error("expected another vote range to be refused")
+	}
+	other = key
+	other.Address = "ABC"
+	if err := MatchKeyreg(offline, other, true); err == nil {
+		t.Error("expected another sender to be refused")
+	}
+}
+
+func Test_SubmitTransaction(t *testing.T) {
+	txId, err := SubmitTransaction(context.Background(), test.GetClient(false), []byte{})
+	if err != nil || txId != "TXID" {
+		t.Errorf("expected the transaction id, got %s and %v", txId, err)
+	}
+	_, err = SubmitTransaction(context.Background(), test.NewClient(false, true), []byte{})
+	if err == nil || err.Error() != "transaction rejected" {
+		t.Errorf("expected the node message, got %v", err)
+	}
+}
+
+func Test_DecodeSignedKeyreg(t *testing.T) {
+	signer := crypto.GenerateAccount()
+	txn, _ := MakeKeyreg(signer.Address.String(), nil, types.SuggestedParams{GenesisHash: bytes.Repeat([]byte{1}, 32), MinFee: 1000}, 0)
+
+	_, err := DecodeSignedKeyreg(EncodeUnsigned(txn))
+	if err == nil || err.Error() != "the transaction is not signed" {
+		t.Errorf("expected an unsigned error, got %v", err)
+	}
+	_, signed, _ := crypto.SignTransaction(signer.PrivateKey, txn)
+	stx, err := DecodeSignedKeyreg(signed)
+	if err != nil || stx.Txn.Sender != signer.Address {
+		t.Errorf("expected the signed key registration, got %v", err)
+	}
+	_, err = DecodeSignedKeyreg([]byte("not msgpack"))
+	if err == nil {
+		t.Error("expected an error for an invalid file")
+	}
+	txn.Type = types.PaymentTx
+	_, signed, _ = crypto.SignTransaction(signer.PrivateKey, txn)
+	_, err = DecodeSignedKeyreg(signed)
+	if err == nil {
+		t.Error("expected an error for a payment")
+	}
+}
+
+func Test_WaitForConfirmation(t *testing.T) {
+	ctx := context.Background()
+	round, err := WaitForConfirmation(ctx, test.GetClient(false), "TXID", ConfirmationRounds)
+	if err != nil || round != 10 {
+		t.Errorf("expected the confirmed round, got %d and %v", round, err)
+	}
+	_, err = WaitForConfirmation(ctx, test.NewClient(false, true), "TXID", ConfirmationRounds)
+	if err == nil {
+		t.Error("expected an error for an unknown transaction")
+	}
+
+	// The fake algod confirms transactions in the next round
+	signer := crypto.GenerateAccount()
+	algod := fake.New(fake.WithRound(100))
+	defer algod.Close()
+	params, err := GetSuggestedParams(ctx, algod.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn, _ := MakeKeyreg(signer.Address.String(), nil, params, 0)
+	_, signed, _ := crypto.SignTransaction(signer.PrivateKey, txn)
+	txId, err := SubmitTransaction(ctx, algod.Client(), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = WaitForConfirmation(ctx, algod.Client(), txId, 0)
+	if err == nil {
+		t.Error("expected the transaction to be pending without waiting")
+	}
+	round, err = WaitForConfirmation(ctx, algod.Client(), txId, ConfirmationRounds)
+	if err != nil || round != 101 {
+		t.Errorf("expected the transaction to be confirmed in round 101, got %d and %v", round, err)
+	}
+}
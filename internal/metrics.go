@@ -3,8 +3,10 @@ package internal
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/algorandfoundation/algorun-tui/api"
-	"regexp"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -20,42 +22,364 @@ type MetricsModel struct {
 	LastTS    time.Time
 	LastRX    int
 	LastTX    int
+	// Families is the latest response from the /metrics endpoint
+	Families MetricsResponse
+	// Rates is the change per second of each series since the previous response, keyed by Sample.Key
+	Rates map[string]float64
+	// History keeps the previous RoundTime, TPS, RX and TX values
+	History MetricsHistory
 }
 
-type MetricsResponse map[string]int
+// MetricType is the TYPE of a metric family in the Prometheus exposition format
+type MetricType string
 
+const (
+	CounterMetric   MetricType = "counter"
+	GaugeMetric     MetricType = "gauge"
+	HistogramMetric MetricType = "histogram"
+	SummaryMetric   MetricType = "summary"
+	UntypedMetric   MetricType = "untyped"
+)
+
+// Labels are the label pairs of a Sample
+type Labels map[string]string
+
+// Matches reports whether every label in the matcher has the same value
+func (l Labels) Matches(matcher Labels) bool {
+	for name, value := range matcher {
+		if l[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Sample is a single series value. Name includes the _bucket, _sum and _count suffixes
+type Sample struct {
+	Name      string
+	Labels    Labels
+	Value     float64
+	Timestamp *int64
+}
+
+// Key identifies the series by its name and sorted labels, like algod_peers{type="in"}
+func (s Sample) Key() string {
+	if len(s.Labels) == 0 {
+		return s.Name
+	}
+	names := make([]string, 0, len(s.Labels))
+	for name := range s.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + strconv.Quote(s.Labels[name])
+	}
+	return s.Name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+// MetricFamily groups the samples of a metric with its HELP and TYPE
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []Sample
+}
+
+// Bucket is a cumulative histogram bucket
+type Bucket struct {
+	UpperBound float64
+	Count      float64
+}
+
+// Quantile is a summary quantile
+type Quantile struct {
+	Quantile float64
+	Value    float64
+}
+
+// MetricsResponse is the parsed /metrics endpoint, keyed by family name
+type MetricsResponse map[string]*MetricFamily
+
+// Family finds the metric family by name
+func (m MetricsResponse) Family(name string) (*MetricFamily, bool) {
+	family, ok := m[name]
+	return family, ok
+}
+
+// Series lists every sample sorted by Key
+func (m MetricsResponse) Series() []Sample {
+	var series []Sample
+	for _, family := range m {
+		series = append(series, family.Samples...)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Key() < series[j].Key()
+	})
+	return series
+}
+
+// Lookup finds a series by its Key
+func (m MetricsResponse) Lookup(key string) (Sample, bool) {
+	name := key
+	if i := strings.Index(key, "{"); i >= 0 {
+		name = key[:i]
+	}
+	for _, sample := range m.samples(name) {
+		if sample.Key() == key {
+			return sample, true
+		}
+	}
+	return Sample{}, false
+}
+
+// Rates is the change per second of every series found in both responses
+func (m MetricsResponse) Rates(previous MetricsResponse, elapsed time.Duration) map[string]float64 {
+	rates := make(map[string]float64)
+	if previous == nil || elapsed <= 0 {
+		return rates
+	}
+	for _, sample := range m.Series() {
+		key := sample.Key()
+		if before, ok := previous.Lookup(key); ok {
+			rates[key] = (sample.Value - before.Value) / elapsed.Seconds()
+		}
+	}
+	return rates
+}
+
+// Value is the first sample with the name and matching labels
+func (m MetricsResponse) Value(name string, matcher Labels) (float64, bool) {
+	for _, sample := range m.samples(name) {
+		if sample.Name == name && sample.Labels.Matches(matcher) {
+			return sample.Value, true
+		}
+	}
+	return 0, false
+}
+
+// Sum adds every sample with the name and matching labels
+func (m MetricsResponse) Sum(name string, matcher Labels) float64 {
+	var sum float64
+	for _, sample := range m.samples(name) {
+		if sample.Name == name && sample.Labels.Matches(matcher) {
+			sum += sample.Value
+		}
+	}
+	return sum
+}
+
+// Buckets returns the sorted buckets of a histogram series
+func (m MetricsResponse) Buckets(name string, matcher Labels) []Bucket {
+	var buckets []Bucket
+	for _, sample := range m.samples(name) {
+		if sample.Name != name+"_bucket" || !sample.Labels.Matches(matcher) {
+			continue
+		}
+		bound, err := parseMetricValue(sample.Labels["le"])
+		if err != nil {
+			continue
+		}
+		buckets = append(buckets, Bucket{UpperBound: bound, Count: sample.Value})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].UpperBound < buckets[j].UpperBound
+	})
+	return buckets
+}
+
+// Quantiles returns the sorted quantiles of a summary series
+func (m MetricsResponse) Quantiles(name string, matcher Labels) []Quantile {
+	var quantiles []Quantile
+	for _, sample := range m.samples(name) {
+		if sample.Name != name || !sample.Labels.Matches(matcher) {
+			continue
+		}
+		q, err := parseMetricValue(sample.Labels["quantile"])
+		if err != nil {
+			continue
+		}
+		quantiles = append(quantiles, Quantile{Quantile: q, Value: sample.Value})
+	}
+	sort.Slice(quantiles, func(i, j int) bool {
+		return quantiles[i].Quantile < quantiles[j].Quantile
+	})
+	return quantiles
+}
+
+// samples looks up the family of a sample name, including histogram and summary suffixes
+func (m MetricsResponse) samples(name string) []Sample {
+	if family, ok := m[name]; ok {
+		return family.Samples
+	}
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		if family, ok := m[strings.TrimSuffix(name, suffix)]; ok && strings.HasSuffix(name, suffix) {
+			return family.Samples
+		}
+	}
+	return nil
+}
+
+// parseMetricsContent parses the Prometheus text exposition format
 func parseMetricsContent(content string) (MetricsResponse, error) {
-	var err error
+	if strings.TrimSpace(content) == "" {
+		return nil, errors.New("invalid metrics content")
+	}
 	result := MetricsResponse{}
+	family := func(name string) *MetricFamily {
+		f, ok := result[name]
+		if !ok {
+			f = &MetricFamily{Name: name, Type: UntypedMetric}
+			result[name] = f
+		}
+		return f
+	}
 
-	// Validate the Content
-	var isValid bool
-	isValid, err = regexp.MatchString(`^#`, content)
-	isValid = isValid && err == nil && content != ""
-	if !isValid {
-		return nil, errors.New("invalid metrics content")
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			fields := strings.Fields(line)
+			if len(fields) < 3 || (fields[1] != "HELP" && fields[1] != "TYPE") {
+				// Other comments are ignored
+				continue
+			}
+			f := family(fields[2])
+			text := strings.TrimSpace(strings.Join(fields[3:], " "))
+			if fields[1] == "HELP" {
+				f.Help = unescapeHelp(text)
+				continue
+			}
+			switch t := MetricType(text); t {
+			case CounterMetric, GaugeMetric, HistogramMetric, SummaryMetric, UntypedMetric:
+				f.Type = t
+			default:
+				return nil, fmt.Errorf("line %d: invalid metric type %q", i+1, text)
+			}
+			continue
+		}
+
+		sample, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		name := sample.Name
+		for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+			base := strings.TrimSuffix(name, suffix)
+			if f, ok := result[base]; ok && base != name &&
+				(f.Type == HistogramMetric || (f.Type == SummaryMetric && suffix != "_bucket")) {
+				name = base
+				break
+			}
+		}
+		f := family(name)
+		f.Samples = append(f.Samples, sample)
 	}
+	return result, nil
+}
 
-	// Regex for Metrics Format,
-	// selects all content that does not start with # in multiline mode
-	re := regexp.MustCompile(`(?m)^[^#].*`)
-	rows := re.FindAllString(content, -1)
+// parseSample parses a line of the form name{label="value",...} value [timestamp]
+func parseSample(line string) (Sample, error) {
+	sample := Sample{Labels: Labels{}}
+	end := strings.IndexAny(line, "{ \t")
+	if end <= 0 {
+		return sample, fmt.Errorf("invalid sample %q", line)
+	}
+	sample.Name = line[:end]
+	rest := line[end:]
 
-	// Add the strings to the map
-	for _, row := range rows {
-		var value int
-		keyValue := strings.Split(row, " ")
-		value, err = strconv.Atoi(keyValue[1])
-		result[keyValue[0]] = value
+	if strings.HasPrefix(rest, "{") {
+		rest = rest[1:]
+		for {
+			rest = strings.TrimLeft(rest, " \t")
+			if strings.HasPrefix(rest, "}") {
+				rest = rest[1:]
+				break
+			}
+			eq := strings.Index(rest, "=")
+			if eq <= 0 {
+				return sample, fmt.Errorf("invalid labels in %q", line)
+			}
+			name := strings.TrimSpace(rest[:eq])
+			value, remaining, err := parseLabelValue(strings.TrimLeft(rest[eq+1:], " \t"))
+			if err != nil {
+				return sample, fmt.Errorf("%w in %q", err, line)
+			}
+			sample.Labels[name] = value
+			rest = strings.TrimLeft(remaining, " \t")
+			rest = strings.TrimPrefix(rest, ",")
+		}
 	}
 
-	// Handle any error results
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample, fmt.Errorf("invalid sample %q", line)
+	}
+	value, err := parseMetricValue(fields[0])
 	if err != nil {
-		return nil, err
+		return sample, err
+	}
+	sample.Value = value
+	if len(fields) == 2 {
+		timestamp, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return sample, fmt.Errorf("invalid timestamp %q", fields[1])
+		}
+		sample.Timestamp = &timestamp
 	}
+	return sample, nil
+}
 
-	// Give the user what they asked for
-	return result, nil
+// parseLabelValue reads a quoted label value and returns the remaining input
+func parseLabelValue(input string) (string, string, error) {
+	if !strings.HasPrefix(input, `"`) {
+		return "", input, errors.New("unquoted label value")
+	}
+	var b strings.Builder
+	for i := 1; i < len(input); i++ {
+		switch c := input[i]; c {
+		case '"':
+			return b.String(), input[i+1:], nil
+		case '\\':
+			i++
+			if i == len(input) {
+				break
+			}
+			switch input[i] {
+			case 'n':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(input[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", errors.New("unterminated label value")
+}
+
+// parseMetricValue accepts floats and the NaN, +Inf and -Inf spellings of the exposition format
+func parseMetricValue(value string) (float64, error) {
+	switch value {
+	case "NaN":
+		return math.NaN(), nil
+	case "+Inf", "Inf":
+		return math.Inf(1), nil
+	case "-Inf":
+		return math.Inf(-1), nil
+	}
+	// strconv also accepts spellings like NAN and infinity
+	if strings.ContainsAny(value, "nN") {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+func unescapeHelp(help string) string {
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n").Replace(help)
 }
 
 // GetMetrics parses the /metrics endpoint from algod into a map
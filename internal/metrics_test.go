@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"math"
 	"strconv"
 	"testing"
 )
@@ -21,8 +22,11 @@ func Test_GetMetrics(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if metrics["algod_agreement_dropped"] != 0 {
-		t.Fatal(strconv.Itoa(metrics["algod_agreement_dropped"]) + " is not zero")
+	if _, ok := metrics.Value("algod_agreement_dropped", nil); ok {
+		t.Fatal("algod_agreement_dropped should not exist")
+	}
+	if value, ok := metrics.Value("algod_telemetry_drops_total", nil); !ok || value != 0 {
+		t.Fatal(strconv.FormatFloat(value, 'f', -1, 64) + " is not zero")
 	}
 
 	client = test.NewClient(false, true)
@@ -58,8 +62,12 @@ algod_crypto_vrf_hash_total 0
 		t.Fatal(err)
 	}
 
-	if metrics["algod_telemetry_drops_total"] != 0 {
-		t.Fatal(strconv.Itoa(metrics["algod_telemetry_drops_total"]) + " is not 0")
+	if value, _ := metrics.Value("algod_telemetry_drops_total", nil); value != 0 {
+		t.Fatal(strconv.FormatFloat(value, 'f', -1, 64) + " is not 0")
+	}
+	if family, ok := metrics.Family("algod_ram_usage"); !ok || family.Type != GaugeMetric ||
+		family.Help != "number of bytes runtime.ReadMemStats().HeapInuse" {
+		t.Errorf("unexpected family %+v", family)
 	}
 
 	content = `INVALID`
@@ -76,3 +84,83 @@ algod_telemetry_drops_total NAN`
 		t.Fatal(err)
 	}
 }
+
+func Test_parseMetricsFamilies(t *testing.T) {
+	content := `# HELP algod_network_sent_bytes_total Bytes sent\nper tag
+# TYPE algod_network_sent_bytes_total counter
+algod_network_sent_bytes_total{tag="AV"} 1.5e3
+algod_network_sent_bytes_total{tag="TX",peer="a \"b\""} 500 1700000000000
+# TYPE algod_ledger_round gauge
+algod_ledger_round +Inf
+algod_untyped NaN
+# HELP algod_round_seconds Time between rounds
+# TYPE algod_round_seconds histogram
+algod_round_seconds_bucket{le="3"} 2
+algod_round_seconds_bucket{le="+Inf"} 5
+algod_round_seconds_bucket{le="1"} 1
+algod_round_seconds_sum 14.2
+algod_round_seconds_count 5
+# TYPE algod_vote_seconds summary
+algod_vote_seconds{quantile="0.99"} 0.9
+algod_vote_seconds{quantile="0.5"} 0.2
+algod_vote_seconds_sum 3
+algod_vote_seconds_count 10
+`
+	metrics, err := parseMetricsContent(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sum := metrics.Sum("algod_network_sent_bytes_total", nil); sum != 2000 {
+		t.Errorf("expected 2000 bytes sent, got %v", sum)
+	}
+	if value, ok := metrics.Value("algod_network_sent_bytes_total", Labels{"peer": `a "b"`}); !ok || value != 500 {
+		t.Errorf("expected the escaped label to match, got %v", value)
+	}
+	family, _ := metrics.Family("algod_network_sent_bytes_total")
+	if family.Help != "Bytes sent\nper tag" || family.Type != CounterMetric {
+		t.Errorf("unexpected family %+v", family)
+	}
+	if timestamp := family.Samples[1].Timestamp; timestamp == nil || *timestamp != 1700000000000 {
+		t.Errorf("expected a timestamp, got %v", timestamp)
+	}
+	if value, _ := metrics.Value("algod_ledger_round", nil); !math.IsInf(value, 1) {
+		t.Errorf("expected +Inf, got %v", value)
+	}
+	if family, ok := metrics.Family("algod_untyped"); !ok || family.Type != UntypedMetric || !math.IsNaN(family.Samples[0].Value) {
+		t.Errorf("expected an untyped NaN, got %+v", family)
+	}
+
+	family, _ = metrics.Family("algod_round_seconds")
+	if len(family.Samples) != 5 {
+		t.Errorf("expected the histogram samples in one family, got %d", len(family.Samples))
+	}
+	buckets := metrics.Buckets("algod_round_seconds", nil)
+	if len(buckets) != 3 || buckets[0].UpperBound != 1 || !math.IsInf(buckets[2].UpperBound, 1) || buckets[2].Count != 5 {
+		t.Errorf("unexpected buckets %+v", buckets)
+	}
+	if sum, ok := metrics.Value("algod_round_seconds_sum", nil); !ok || sum != 14.2 {
+		t.Errorf("expected the histogram sum, got %v", sum)
+	}
+
+	quantiles := metrics.Quantiles("algod_vote_seconds", nil)
+	if len(quantiles) != 2 || quantiles[0].Quantile != 0.5 || quantiles[1].Value != 0.9 {
+		t.Errorf("unexpected quantiles %+v", quantiles)
+	}
+	if count, _ := metrics.Value("algod_vote_seconds_count", nil); count != 10 {
+		t.Errorf("expected the summary count, got %v", count)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"algod_round 1 2 3",
+		`algod_round{tag="AV} 1`,
+		"algod_round{tag=AV} 1",
+		"algod_round 1 later",
+		"# TYPE algod_round meter",
+	} {
+		if _, err = parseMetricsContent(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
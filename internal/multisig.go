@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// MultisigVersion is the only version of multisig accounts on the ledger
+const MultisigVersion = 1
+
+// NewMultisig describes a multisig account from its threshold and its ordered signers
+func NewMultisig(threshold int, signers []string) (crypto.MultisigAccount, error) {
+	if threshold < 1 || threshold > len(signers) || len(signers) > 255 {
+		return crypto.MultisigAccount{}, fmt.Errorf("threshold must be between 1 and %d signers", len(signers))
+	}
+	addrs := make([]types.Address, len(signers))
+	for i, signer := range signers {
+		addr, err := types.DecodeAddress(signer)
+		if err != nil {
+			return crypto.MultisigAccount{}, fmt.Errorf("invalid signer %s: %w", signer, err)
+		}
+		addrs[i] = addr
+	}
+	return crypto.MultisigAccountWithParams(MultisigVersion, uint8(threshold), addrs)
+}
+
+// ExportMultisig encodes the transaction with the blank signatures of the multisig account,
+// each signer adds its signature to a copy of the file. The multisig account signs for
+// the sender when the sender is rekeyed to it.
+func ExportMultisig(txn types.Transaction, ma crypto.MultisigAccount) ([]byte, error) {
+	addr, err := ma.Address()
+	if err != nil {
+		return nil, err
+	}
+	stx := types.SignedTxn{
+		Txn: txn,
+		Msig: types.MultisigSig{
+			Version:   ma.Version,
+			Threshold: ma.Threshold,
+			Subsigs:   make([]types.MultisigSubsig, len(ma.Pks)),
+		},
+	}
+	for i, pk := range ma.Pks {
+		stx.Msig.Subsigs[i].Key = pk
+	}
+	if addr != txn.Sender {
+		stx.AuthAddr = addr
+	}
+	return msgpack.Encode(stx), nil
+}
+
+// AuthorizingAddress is the address expected to sign for an account, its AuthAddr when rekeyed
+func AuthorizingAddress(account Account) string {
+	if account.AuthAddr != "" {
+		return account.AuthAddr
+	}
+	return account.Address
+}
+
+// MergeMultisig combines the partially signed copies of an exported transaction,
+// it fails until the threshold of signatures is reached
+func MergeMultisig(parts ...[]byte) (string, []byte, error) {
+	if len(parts) == 0 {
+		return "", nil, errors.New("no signed transaction to merge")
+	}
+	merged := parts[0]
+	if len(parts) > 1 {
+		var err error
+		_, merged, err = crypto.MergeMultisigTransactions(parts...)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	var stx types.SignedTxn
+	err := msgpack.Decode(merged, &stx)
+	if err != nil {
+		return "", nil, err
+	}
+	if stx.Msig.Blank() {
+		return "", nil, errors.New("transaction is not a multisig transaction")
+	}
+	signatures := 0
+	for _, subsig := range stx.Msig.Subsigs {
+		if subsig.Sig != (types.Signature{}) {
+			signatures++
+		}
+	}
+	if signatures < int(stx.Msig.Threshold) {
+		return "", nil, fmt.Errorf("%d of %d signatures, the transaction needs %d",
+			signatures, len(stx.Msig.Subsigs), stx.Msig.Threshold)
+	}
+	return crypto.GetTxID(stx.Txn), merged, nil
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+func Test_Multisig(t *testing.T) {
+	signers := []crypto.Account{crypto.GenerateAccount(), crypto.GenerateAccount(), crypto.GenerateAccount()}
+	addresses := make([]string, len(signers))
+	for i, signer := range signers {
+		addresses[i] = signer.Address.String()
+	}
+	_, err := NewMultisig(4, addresses)
+	if err == nil {
+		t.Error("expected an error for a threshold above the signers")
+	}
+	_, err = NewMultisig(1, []string{"ABC"})
+	if err == nil {
+		t.Error("expected an error for an invalid signer")
+	}
+	ma, err := NewMultisig(2, addresses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msig, _ := ma.Address()
+
+	// The sender was rekeyed to the multisig account
+	sender := crypto.GenerateAccount().Address
+	txn, err := MakeKeyreg(sender.String(), nil, types.SuggestedParams{GenesisHash: bytes.Repeat([]byte{1}, 32), MinFee: 1000}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned, err := ExportMultisig(txn, ma)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stx types.SignedTxn
+	err = msgpack.Decode(unsigned, &stx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stx.AuthAddr != msig || len(stx.Msig.Subsigs) != 3 || stx.Msig.Threshold != 2 {
+		t.Errorf("expected the multisig preimage, got %+v", stx)
+	}
+
+	_, first, _ := crypto.AppendMultisigTransaction(signers[0].PrivateKey, ma, unsigned)
+	_, second, _ := crypto.AppendMultisigTransaction(signers[1].PrivateKey, ma, unsigned)
+	_, _, err = MergeMultisig(first)
+	if err == nil || err.Error() != "1 of 3 signatures, the transaction needs 2" {
+		t.Errorf("expected the missing signatures, got %v", err)
+	}
+	txId, merged, err := MergeMultisig(first, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = msgpack.Decode(merged, &stx)
+	message := append([]byte("TX"), msgpack.Encode(stx.Txn)...)
+	if txId != crypto.GetTxID(txn) || !crypto.VerifyMultisig(msig, message, stx.Msig) {
+		t.Errorf("expected a valid multisig transaction %s", txId)
+	}
+
+	_, _, err = MergeMultisig()
+	if err == nil {
+		t.Error("expected an error without transactions")
+	}
+	_, _, err = MergeMultisig(msgpack.Encode(types.SignedTxn{Txn: txn}))
+	if err == nil {
+		t.Error("expected an error for a transaction without multisig")
+	}
+}
+
+func Test_AuthorizingAddress(t *testing.T) {
+	if AuthorizingAddress(Account{Address: "ABC"}) != "ABC" {
+		t.Error("expected the account to sign for itself")
+	}
+	if AuthorizingAddress(Account{Address: "ABC", AuthAddr: "DEF"}) != "DEF" {
+		t.Error("expected the rekeyed account to be signed by its AuthAddr")
+	}
+}
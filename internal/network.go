@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// Network describes a network the TUI knows, found by the genesis hash of the node
+type Network struct {
+	// Name labels the network in the TUI
+	Name string `mapstructure:"name"`
+	// GenesisHash is the base64 genesis hash of the network
+	GenesisHash string `mapstructure:"genesis-hash"`
+	// GenesisId finds the network when the genesis hash is unknown, or when the network has no GenesisHash
+	GenesisId string `mapstructure:"genesis-id"`
+	// Lora is the name of the network in Lora and the short link service, empty when they don't support it
+	Lora string `mapstructure:"lora"`
+	// ExplorerURL is the page of a transaction, {txid} is replaced with its id
+	ExplorerURL string `mapstructure:"explorer-url"`
+	// WalletURL opens a key registration in a wallet, {query} is replaced with the transaction wizard query
+	WalletURL string `mapstructure:"wallet-url"`
+	// QR is true when mobile wallets can scan the key registration
+	QR bool `mapstructure:"qr"`
+	// Local networks are development networks the DevSigner signs for
+	Local bool `mapstructure:"local"`
+}
+
+// LocalNetworks are the genesis names of the development networks found without a genesis hash
+var LocalNetworks = []string{"dockernet", "tuinet", "devnet"}
+
+// DefaultNetworks are the public networks and the localnet of AlgoKit.
+// fnet is reset regularly so it is found by its genesis id.
+var DefaultNetworks = NetworkRegistry{
+	{
+		Name:        "mainnet",
+		GenesisHash: "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=",
+		GenesisId:   "mainnet-v1.0",
+		Lora:        "mainnet",
+		ExplorerURL: loraExplorerURL("mainnet"),
+		WalletURL:   loraWalletURL("mainnet"),
+		QR:          true,
+	},
+	{
+		Name:        "testnet",
+		GenesisHash: "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=",
+		GenesisId:   "testnet-v1.0",
+		Lora:        "testnet",
+		ExplorerURL: loraExplorerURL("testnet"),
+		WalletURL:   loraWalletURL("testnet"),
+		QR:          true,
+	},
+	{
+		Name:        "betanet",
+		GenesisHash: "mFgazF+2uRS1tMiL9dsj01hJGySEmPN28B/TjjvpVW0=",
+		GenesisId:   "betanet-v1.0",
+		Lora:        "betanet",
+		ExplorerURL: loraExplorerURL("betanet"),
+		WalletURL:   loraWalletURL("betanet"),
+	},
+	{
+		Name:        "fnet",
+		GenesisId:   "fnet-v1",
+		Lora:        "fnet",
+		ExplorerURL: loraExplorerURL("fnet"),
+		WalletURL:   loraWalletURL("fnet"),
+	},
+	{
+		Name:        "localnet",
+		Lora:        "localnet",
+		ExplorerURL: loraExplorerURL("localnet"),
+		WalletURL:   loraWalletURL("localnet"),
+		Local:       true,
+	},
+}
+
+// NetworkRegistry lists the known networks, the first match wins
+type NetworkRegistry []Network
+
+// NewNetworkRegistry checks the custom networks of the configuration before the DefaultNetworks
+func NewNetworkRegistry(custom []Network) (NetworkRegistry, error) {
+	for _, network := range custom {
+		if network.Name == "" {
+			return nil, errors.New("invalid network, a name is required")
+		}
+		if network.GenesisHash == "" && network.GenesisId == "" && !network.Local {
+			return nil, fmt.Errorf("invalid network %s, a genesis-hash or genesis-id is required", network.Name)
+		}
+	}
+	return append(slices.Clone(custom), DefaultNetworks...), nil
+}
+
+// Find the network of a node from its base64 genesis hash and genesis id, a development network
+// is localnet when it runs in dev mode. Unknown networks are named after the genesis id and have no links.
+func (r NetworkRegistry) Find(genesisHash string, genesisId string, devMode bool) Network {
+	if r == nil {
+		r = DefaultNetworks
+	}
+	for _, network := range r {
+		if network.matches(genesisHash, genesisId, devMode) {
+			return network
+		}
+	}
+	return Network{Name: genesisId, GenesisHash: genesisHash, GenesisId: genesisId}
+}
+
+func (n Network) matches(genesisHash string, genesisId string, devMode bool) bool {
+	if n.GenesisHash != "" && genesisHash != "" {
+		return n.GenesisHash == genesisHash
+	}
+	if n.GenesisId != "" {
+		return n.GenesisId == genesisId
+	}
+	name, _, _ := strings.Cut(genesisId, "-v")
+	return n.Local && (devMode || slices.Contains(LocalNetworks, name))
+}
+
+// ExplorerLink is the page of a transaction, empty without an ExplorerURL
+func (n Network) ExplorerLink(txId string) string {
+	return strings.Replace(n.ExplorerURL, "{txid}", url.PathEscape(txId), 1)
+}
+
+// WalletLink opens the transaction wizard query of a key registration
+func (n Network) WalletLink(query string) (string, error) {
+	if n.WalletURL == "" {
+		return "", fmt.Errorf("no wallet link for the %s network, add its wallet-url to the networks of the configuration", n.Name)
+	}
+	return strings.Replace(n.WalletURL, "{query}", query, 1), nil
+}
+
+func loraExplorerURL(network string) string {
+	return fmt.Sprintf("https://lora.algokit.io/%s/transaction/{txid}", network)
+}
+
+func loraWalletURL(network string) string {
+	return fmt.Sprintf("https://lora.algokit.io/%s/transaction-wizard?{query}", url.PathEscape(network))
+}
+
+// Genesis is the part of the genesis file of the node used to find its network
+type Genesis struct {
+	Network string `json:"network"`
+	Id      string `json:"id"`
+	Proto   string `json:"proto"`
+	DevMode bool   `json:"devmode"`
+}
+
+// GetGenesis reads the genesis file of the node
+func GetGenesis(ctx context.Context, client api.ClientWithResponsesInterface) (Genesis, error) {
+	res, err := client.GetGenesisWithResponse(ctx)
+	if err != nil {
+		return Genesis{}, err
+	}
+	if res.StatusCode() != 200 || res.JSON200 == nil {
+		return Genesis{}, fmt.Errorf("Status code %d: %s", res.StatusCode(), res.Status())
+	}
+	genesis := Genesis{Network: res.JSON200.Network, Id: res.JSON200.Id, Proto: res.JSON200.Proto}
+	if res.JSON200.Devmode != nil {
+		genesis.DevMode = *res.JSON200.Devmode
+	}
+	return genesis, nil
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_NetworkRegistry_Find(t *testing.T) {
+	mainnet := "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8="
+	for _, tc := range []struct {
+		hash    string
+		id      string
+		devMode bool
+		name    string
+		local   bool
+	}{
+		{hash: mainnet, id: "mainnet-v1.0", name: "mainnet"},
+		{hash: mainnet, id: "renamed-v1", name: "mainnet"},
+		{hash: "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=", id: "testnet-v1.0", name: "testnet"},
+		{hash: "c3B1cmlvdXM=", id: "mainnet-v1.0", name: "mainnet-v1.0"},
+		{id: "testnet-v1.0", name: "testnet"},
+		{id: "fnet-v1", name: "fnet"},
+		{id: "tuinet-v1.0", name: "localnet", local: true},
+		{id: "dockernet-v1", name: "localnet", local: true},
+		{id: "devnet-v1", name: "localnet", local: true},
+		{hash: "c3B1cmlvdXM=", id: "private-v1", devMode: true, name: "localnet", local: true},
+		{hash: "c3B1cmlvdXM=", id: "private-v1", name: "private-v1"},
+	} {
+		network := DefaultNetworks.Find(tc.hash, tc.id, tc.devMode)
+		if network.Name != tc.name || network.Local != tc.local {
+			t.Errorf("expected %s (%s) to be %s local: %v, got %+v", tc.id, tc.hash, tc.name, tc.local, network)
+		}
+	}
+
+	var nilRegistry NetworkRegistry
+	if nilRegistry.Find("", "testnet-v1.0", false).Name != "testnet" {
+		t.Error("expected a nil registry to find the default networks")
+	}
+}
+
+func Test_NewNetworkRegistry(t *testing.T) {
+	registry, err := NewNetworkRegistry([]Network{{
+		Name:        "private",
+		GenesisHash: "c3B1cmlvdXM=",
+		ExplorerURL: "https://explorer.example.com/tx/{txid}",
+		WalletURL:   "https://wallet.example.com/keyreg?{query}",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	network := registry.Find("c3B1cmlvdXM=", "private-v1", false)
+	if network.Name != "private" || network.ExplorerLink("TXID") != "https://explorer.example.com/tx/TXID" {
+		t.Errorf("expected the custom network, got %+v", network)
+	}
+	link, err := network.WalletLink("type%5B0%5D=keyreg")
+	if err != nil || link != "https://wallet.example.com/keyreg?type%5B0%5D=keyreg" {
+		t.Errorf("unexpected wallet link %s %v", link, err)
+	}
+	if registry.Find("", "testnet-v1.0", false).Name != "testnet" {
+		t.Error("expected the default networks after the custom ones")
+	}
+
+	_, err = NewNetworkRegistry([]Network{{GenesisId: "private-v1"}})
+	if err == nil {
+		t.Error("expected a network without a name to be refused")
+	}
+	_, err = NewNetworkRegistry([]Network{{Name: "private"}})
+	if err == nil {
+		t.Error("expected a network without a genesis to be refused")
+	}
+}
+
+func Test_GetGenesis(t *testing.T) {
+	algod := fake.New()
+	algod.GenesisId = "private-v1"
+	algod.DevMode = true
+	defer algod.Close()
+
+	genesis, err := GetGenesis(context.Background(), algod.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesis.Network != "private" || genesis.Id != "v1" || !genesis.DevMode {
+		t.Errorf("unexpected genesis %+v", genesis)
+	}
+
+	status := StatusModel{}
+	err = status.Fetch(context.Background(), test.GetClient(false), new(testResponse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := StateModel{Status: status}
+	if !status.DevMode || state.Network().Name != "localnet" {
+		t.Errorf("expected the dev mode network to be localnet, got %+v", state.Network())
+	}
+
+	// A node without a readable genesis isn't in dev mode
+	status = StatusModel{}
+	err = status.Fetch(context.Background(), noGenesisClient{test.GetClient(false)}, new(testResponse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.DevMode || status.Version == "" {
+		t.Errorf("expected the status without dev mode, got %+v", status)
+	}
+}
+
+// noGenesisClient fails to read the genesis
+type noGenesisClient struct {
+	api.ClientWithResponsesInterface
+}
+
+func (noGenesisClient) GetGenesisWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetGenesisResponse, error) {
+	return nil, errors.New("test error")
+}
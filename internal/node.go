@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// PidFileName is the file in the data directory holding the algod process id
+const PidFileName = "algod.pid"
+
+// startGrace is how long algod must keep running after Start before its pid is recorded
+const startGrace = time.Millisecond * 500
+
+var (
+	ErrNodeRunning    = errors.New("algod is already running")
+	ErrNodeNotRunning = errors.New("algod is not running")
+)
+
+// Node controls a local algod process for an ALGORAND_DATA directory
+type Node struct {
+	// Binary is the algod executable, looked up in the PATH when it is not a path
+	Binary string
+	// DataDir is the ALGORAND_DATA directory passed to algod
+	DataDir string
+	// StopTimeout is how long to wait after SIGTERM before sending SIGKILL
+	StopTimeout time.Duration
+}
+
+// PidPath is the location of the pid file in the data directory
+func (n Node) PidPath() string {
+	return filepath.Join(n.DataDir, PidFileName)
+}
+
+// Pid reads the process id from the pid file
+func (n Node) Pid() (int, error) {
+	data, err := os.ReadFile(n.PidPath())
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid pid file %s: %w", n.PidPath(), err)
+	}
+	return pid, nil
+}
+
+// Running checks the pid file points to a live algod process
+func (n Node) Running() bool {
+	pid, err := n.Pid()
+	if err != nil {
+		return false
+	}
+	return n.isAlgod(pid)
+}
+
+// binary is the executable of algod, "algod" when it is not configured
+func (n Node) binary() string {
+	if n.Binary == "" {
+		return "algod"
+	}
+	return n.Binary
+}
+
+// isAlgod checks the process is alive and runs the algod binary. After a crash or a reboot
+// the pid file can point to an unrelated process. Without /proc only the liveness is checked
+func (n Node) isAlgod(pid int) bool {
+	if !isAlive(pid) {
+		return false
+	}
+	cmdline, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "cmdline"))
+	if err != nil {
+		_, procErr := os.Stat("/proc/self")
+		return procErr != nil
+	}
+	args := strings.Split(string(cmdline), "\x00")
+	return filepath.Base(args[0]) == filepath.Base(n.binary())
+}
+
+// Start launches algod in the background and records its pid
+func (n Node) Start() (int, error) {
+	if n.Running() {
+		return 0, ErrNodeRunning
+	}
+	info, err := os.Stat(n.DataDir)
+	if err != nil {
+		return 0, err
+	}
+	if !info.IsDir() {
+		return 0, fmt.Errorf("%s is not a directory", n.DataDir)
+	}
+	cmd := exec.Command(n.binary(), "-d", n.DataDir)
+	err = cmd.Start()
+	if err != nil {
+		return 0, err
+	}
+	pid := cmd.Process.Pid
+	// Reap the process when it exits while we are still running
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	// Only record algod once it survived its startup, e.g. a locked or invalid data directory
+	select {
+	case err = <-exited:
+		if err == nil {
+			err = errors.New("exit status 0")
+		}
+		return 0, fmt.Errorf("algod exited on start: %w", err)
+	case <-time.After(startGrace):
+	}
+
+	err = os.WriteFile(n.PidPath(), []byte(strconv.Itoa(pid)), 0644)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		return 0, err
+	}
+	return pid, nil
+}
+
+// Stop sends SIGTERM and falls back to SIGKILL after the StopTimeout
+func (n Node) Stop(ctx context.Context) error {
+	pid, err := n.Pid()
+	if err != nil || !n.isAlgod(pid) {
+		return ErrNodeNotRunning
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	err = process.Signal(syscall.SIGTERM)
+	if err != nil {
+		return err
+	}
+
+	timeout := n.StopTimeout
+	if timeout == 0 {
+		timeout = time.Second * 30
+	}
+	if !waitForExit(ctx, pid, timeout) {
+		err = process.Signal(syscall.SIGKILL)
+		if err != nil && isAlive(pid) {
+			return err
+		}
+		if !waitForExit(ctx, pid, time.Second*5) {
+			return fmt.Errorf("algod (pid %d) did not exit", pid)
+		}
+	}
+	_ = os.Remove(n.PidPath())
+	return nil
+}
+
+// Restart stops algod when it is running and starts it again
+func (n Node) Restart(ctx context.Context) (int, error) {
+	err := n.Stop(ctx)
+	if err != nil && !errors.Is(err, ErrNodeNotRunning) {
+		return 0, err
+	}
+	return n.Start()
+}
+
+// WaitForStatus polls /v2/status until algod answers or the context is done.
+// The client is created on every attempt since the endpoint may only be
+// known once algod has written algod.net
+func WaitForStatus(ctx context.Context, getClient func() (api.ClientWithResponsesInterface, error), interval time.Duration) error {
+	var lastErr error
+	for {
+		client, err := getClient()
+		if err == nil {
+			var res *api.GetStatusResponse
+			res, err = client.GetStatusWithResponse(ctx)
+			if err == nil && res.StatusCode() == 200 {
+				return nil
+			}
+			if err == nil {
+				err = errors.New(res.Status())
+			}
+		}
+		lastErr = err
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("algod did not answer: %w", lastErr)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isAlive uses the null signal to check a process exists
+func isAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// waitForExit polls the process until it is gone or the timeout passes
+func waitForExit(ctx context.Context, pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if !isAlive(pid) {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return !isAlive(pid)
+		case <-time.After(time.Millisecond * 50):
+		}
+	}
+	return !isAlive(pid)
+}
@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestMain lets the test binary act as a stub algod when it is launched by Node.Start
+func TestMain(m *testing.M) {
+	if os.Getenv("ALGORUN_STUB_ALGOD") != "" {
+		stubAlgod()
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// stubAlgod serves /v2/status and writes algod.net like algod does.
+// Set ALGORUN_STUB_ALGOD=stubborn to ignore SIGTERM, or crash to exit right away.
+func stubAlgod() {
+	if os.Getenv("ALGORUN_STUB_ALGOD") == "crash" {
+		os.Exit(1)
+	}
+	dataDir := os.Args[len(os.Args)-1]
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		os.Exit(1)
+	}
+	_ = os.WriteFile(filepath.Join(dataDir, "algod.net"), []byte(listener.Addr().String()), 0644)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM)
+	go func() {
+		for range signals {
+			if os.Getenv("ALGORUN_STUB_ALGOD") != "stubborn" {
+				os.Exit(0)
+			}
+		}
+	}()
+
+	_ = http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"last-round": 1}`)
+	}))
+}
+
+func getStubNode(t *testing.T, mode string) Node {
+	t.Setenv("ALGORUN_STUB_ALGOD", mode)
+	binary, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Node{
+		Binary:      binary,
+		DataDir:     t.TempDir(),
+		StopTimeout: time.Millisecond * 500,
+	}
+}
+
+func getStubClient(node Node) func() (api.ClientWithResponsesInterface, error) {
+	return func() (api.ClientWithResponsesInterface, error) {
+		addr, err := os.ReadFile(filepath.Join(node.DataDir, "algod.net"))
+		if err != nil {
+			return nil, err
+		}
+		return api.NewClientWithResponses("http://" + string(addr))
+	}
+}
+
+func Test_NodeLifecycle(t *testing.T) {
+	node := getStubNode(t, "graceful")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	if node.Running() {
+		t.Fatal("expected the node to be stopped")
+	}
+	err := node.Stop(ctx)
+	if !errors.Is(err, ErrNodeNotRunning) {
+		t.Errorf("expected ErrNodeNotRunning, got %v", err)
+	}
+
+	pid, err := node.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, err := node.Pid()
+	if err != nil || saved != pid {
+		t.Errorf("expected pid %d in the pid file, got %d %v", pid, saved, err)
+	}
+	err = WaitForStatus(ctx, getStubClient(node), time.Millisecond*50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = node.Start()
+	if !errors.Is(err, ErrNodeRunning) {
+		t.Errorf("expected ErrNodeRunning, got %v", err)
+	}
+
+	restarted, err := node.Restart(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restarted == pid {
+		t.Error("expected a new process after the restart")
+	}
+
+	err = node.Stop(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Running() {
+		t.Error("expected the node to be stopped")
+	}
+	if _, err := os.Stat(node.PidPath()); !os.IsNotExist(err) {
+		t.Error("expected the pid file to be removed")
+	}
+}
+
+func Test_NodeStopKill(t *testing.T) {
+	node := getStubNode(t, "stubborn")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := node.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WaitForStatus(ctx, getStubClient(node), time.Millisecond*50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	err = node.Stop(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) < node.StopTimeout {
+		t.Error("expected SIGKILL after the stop timeout")
+	}
+	if node.Running() {
+		t.Error("expected the node to be killed")
+	}
+}
+
+func Test_NodeStartErrors(t *testing.T) {
+	node := Node{Binary: "algod-does-not-exist", DataDir: t.TempDir()}
+	_, err := node.Start()
+	if err == nil {
+		t.Error("expected an error for a missing binary")
+	}
+	node.DataDir = filepath.Join(node.DataDir, "missing")
+	_, err = node.Start()
+	if err == nil {
+		t.Error("expected an error for a missing data directory")
+	}
+
+	err = os.MkdirAll(node.DataDir, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(node.PidPath(), []byte("invalid"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = node.Pid()
+	if err == nil {
+		t.Error("expected an invalid pid file error")
+	}
+}
+
+func Test_NodeStartCrash(t *testing.T) {
+	node := getStubNode(t, "crash")
+	_, err := node.Start()
+	if err == nil {
+		t.Fatal("expected an error when algod exits on start")
+	}
+	if _, err := os.Stat(node.PidPath()); !os.IsNotExist(err) {
+		t.Error("expected no pid file")
+	}
+}
+
+func Test_NodeStalePid(t *testing.T) {
+	node := Node{Binary: "algod", DataDir: t.TempDir()}
+	// The pid of a crashed algod now belongs to another process, the test itself
+	err := os.WriteFile(node.PidPath(), []byte(strconv.Itoa(os.Getpid())), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Running() {
+		t.Error("expected the node to be stopped")
+	}
+	err = node.Stop(context.Background())
+	if !errors.Is(err, ErrNodeNotRunning) {
+		t.Errorf("expected ErrNodeNotRunning, got %v", err)
+	}
+}
+
+func Test_WaitForStatus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+	defer cancel()
+	err := WaitForStatus(ctx, func() (api.ClientWithResponsesInterface, error) {
+		return test.GetClient(false), nil
+	}, time.Millisecond*10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WaitForStatus(ctx, func() (api.ClientWithResponsesInterface, error) {
+		return nil, errors.New("no endpoint")
+	}, time.Millisecond*10)
+	if err == nil {
+		t.Error("expected a timeout")
+	}
+}
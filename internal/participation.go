@@ -110,36 +110,15 @@ func FindParticipationIdForVoteKey(slice *[]api.ParticipationKey, votekey []byte
 	return nil
 }
 
-func ToLoraDeepLink(network string, offline bool, incentiveEligible bool, part api.ParticipationKey) (string, error) {
-	var loraNetwork = strings.Replace(strings.Replace(network, "-v1.0", "", 1), "-v1", "", 1)
-	if loraNetwork == "dockernet" || loraNetwork == "tuinet" {
-		loraNetwork = "localnet"
-	}
-
-	var query = ""
-	encodedIndex := url.QueryEscape("[0]")
+// ToWalletLink opens the key registration with the WalletURL of the network,
+// an online registration pays fee when it is not 0
+func ToWalletLink(network Network, offline bool, fee uint64, part api.ParticipationKey) (string, error) {
 	if offline {
-		query = fmt.Sprintf(
-			"type[0]=keyreg&sender[0]=%s",
-			part.Address,
-		)
-	} else {
-		query = fmt.Sprintf(
-			"type[0]=keyreg&sender[0]=%s&selkey[0]=%s&sprfkey[0]=%s&votekey[0]=%s&votefst[0]=%d&votelst[0]=%d&votekd[0]=%d",
-			part.Address,
-			base64.RawURLEncoding.EncodeToString(part.Key.SelectionParticipationKey),
-			base64.RawURLEncoding.EncodeToString(*part.Key.StateProofKey),
-			base64.RawURLEncoding.EncodeToString(part.Key.VoteParticipationKey),
-			part.Key.VoteFirstValid,
-			part.Key.VoteLastValid,
-			part.Key.VoteKeyDilution,
-		)
-		if incentiveEligible {
-			// TODO: enable fee with either feature flag or config flag
-			// query += fmt.Sprintf("&fee[0]=%d", 2000000)
-		}
+		return network.WalletLink(NewOfflineShortLinkBody(network, part).Query())
 	}
-	return fmt.Sprintf("https://lora.algokit.io/%s/transaction-wizard?%s", loraNetwork, strings.Replace(query, "[0]", encodedIndex, -1)), nil
+	body := NewOnlineShortLinkBody(network, part)
+	body.Fee = fee
+	return network.WalletLink(body.Query())
 }
 
 // OnlineShortLinkBody represents the request payload for creating an online short link.
@@ -153,34 +132,93 @@ type OnlineShortLinkBody struct {
 	VoteLastValid    int    `json:"voteLastValid"`
 	KeyDilution      int    `json:"keyDilution"`
 	Network          string `json:"network"`
+	// Fee is the fee in microAlgos, omitted for the suggested fee
+	Fee uint64 `json:"fee,omitempty"`
 }
 
-// GetOnlineShortLink sends a POST request to create an online short link
-// and returns the response or an error if it occurs.
-func GetOnlineShortLink(http HttpPkgInterface, part OnlineShortLinkBody) (ShortLinkResponse, error) {
+// NewOnlineShortLinkBody registers the participation key on the Lora network
+func NewOnlineShortLinkBody(network Network, part api.ParticipationKey) OnlineShortLinkBody {
+	body := OnlineShortLinkBody{
+		Account:         part.Address,
+		VoteKeyB64:      base64.RawURLEncoding.EncodeToString(part.Key.VoteParticipationKey),
+		SelectionKeyB64: base64.RawURLEncoding.EncodeToString(part.Key.SelectionParticipationKey),
+		VoteFirstValid:  part.Key.VoteFirstValid,
+		VoteLastValid:   part.Key.VoteLastValid,
+		KeyDilution:     part.Key.VoteKeyDilution,
+		Network:         network.Lora,
+	}
+	if part.Key.StateProofKey != nil {
+		body.StateProofKeyB64 = base64.RawURLEncoding.EncodeToString(*part.Key.StateProofKey)
+	}
+	return body
+}
+
+// LoraDeepLink is the transaction wizard link the short link redirects to
+func (b OnlineShortLinkBody) LoraDeepLink() string {
+	link, _ := Network{WalletURL: loraWalletURL(b.Network)}.WalletLink(b.Query())
+	return link
+}
+
+// Query is the transaction wizard query of the registration
+func (b OnlineShortLinkBody) Query() string {
+	query := fmt.Sprintf(
+		"type[0]=keyreg&sender[0]=%s&selkey[0]=%s&sprfkey[0]=%s&votekey[0]=%s&votefst[0]=%d&votelst[0]=%d&votekd[0]=%d",
+		url.QueryEscape(b.Account),
+		url.QueryEscape(b.SelectionKeyB64),
+		url.QueryEscape(b.StateProofKeyB64),
+		url.QueryEscape(b.VoteKeyB64),
+		b.VoteFirstValid,
+		b.VoteLastValid,
+		b.KeyDilution,
+	)
+	if b.Fee > 0 {
+		query += fmt.Sprintf("&fee[0]=%d", b.Fee)
+	}
+	return escapeWizardIndexes(query)
+}
+
+// DefaultShortLinkURL is the short link service of NodeKit
+const DefaultShortLinkURL = "https://b.nodekit.run"
+
+// postShortLink creates a short link with a route of the service at base, the default service when empty
+func postShortLink(http HttpPkgInterface, base string, route string, body interface{}) (ShortLinkResponse, error) {
 	var response ShortLinkResponse
-	data, err := json.Marshal(part)
+	data, err := json.Marshal(body)
 	if err != nil {
 		return response, err
 	}
-	res, err := http.Post("http://b.nodekit.run/online", "applicaiton/json", bytes.NewReader(data))
+	res, err := http.Post(shortLinkBase(base)+route, "application/json", bytes.NewReader(data))
 	if err != nil {
 		return response, err
 	}
 	defer res.Body.Close()
+	if res.StatusCode >= 400 {
+		return response, fmt.Errorf("short link service error: %s", res.Status)
+	}
 
 	err = json.NewDecoder(res.Body).Decode(&response)
 	if err != nil {
 		return response, err
 	}
+	if response.Id == "" {
+		return response, errors.New("short link service error: missing id")
+	}
 
 	return response, nil
 }
 
+// GetOnlineShortLink sends a POST request to create an online short link
+// and returns the response or an error if it occurs.
+func GetOnlineShortLink(http HttpPkgInterface, base string, part OnlineShortLinkBody) (ShortLinkResponse, error) {
+	return postShortLink(http, base, "/online", part)
+}
+
 // ShortLinkResponse represents the response structure for a shortened link,
 // containing its unique identifier.
 type ShortLinkResponse struct {
 	Id string `json:"id"`
+	// Fallback is the full Lora link shown instead of the short link when the service failed
+	Fallback string `json:"-"`
 }
 
 // OfflineShortLinkBody represents the request body for creating an
@@ -190,31 +228,49 @@ type OfflineShortLinkBody struct {
 	Network string `json:"network"`
 }
 
+// NewOfflineShortLinkBody registers the account of the participation key offline on the Lora network
+func NewOfflineShortLinkBody(network Network, part api.ParticipationKey) OfflineShortLinkBody {
+	return OfflineShortLinkBody{
+		Account: part.Address,
+		Network: network.Lora,
+	}
+}
+
+// LoraDeepLink is the transaction wizard link the short link redirects to
+func (b OfflineShortLinkBody) LoraDeepLink() string {
+	link, _ := Network{WalletURL: loraWalletURL(b.Network)}.WalletLink(b.Query())
+	return link
+}
+
+// Query is the transaction wizard query of the registration
+func (b OfflineShortLinkBody) Query() string {
+	return escapeWizardIndexes(fmt.Sprintf("type[0]=keyreg&sender[0]=%s", url.QueryEscape(b.Account)))
+}
+
 // GetOfflineShortLink sends an OnlineShortLinkBody to create an offline short link and returns the corresponding response.
 // Uses the provided HttpPkgInterface for the POST request and handles JSON encoding/decoding of request and response.
 // Returns an OfflineShortLinkResponse on success or an error if the operation fails.
-func GetOfflineShortLink(http HttpPkgInterface, offline OfflineShortLinkBody) (ShortLinkResponse, error) {
-	var response ShortLinkResponse
-	data, err := json.Marshal(offline)
-	if err != nil {
-		return response, err
-	}
-	res, err := http.Post("http://b.nodekit.run/offline", "applicaiton/json", bytes.NewReader(data))
-	if err != nil {
-		return response, err
-	}
-	defer res.Body.Close()
+func GetOfflineShortLink(http HttpPkgInterface, base string, offline OfflineShortLinkBody) (ShortLinkResponse, error) {
+	return postShortLink(http, base, "/offline", offline)
+}
 
-	err = json.NewDecoder(res.Body).Decode(&response)
-	if err != nil {
-		return response, err
+// ToShortLink generates a shortened URL string using the unique
+// identifier from the provided ShortLinkResponse, or its Fallback.
+func ToShortLink(base string, link ShortLinkResponse) string {
+	if link.Fallback != "" {
+		return link.Fallback
 	}
+	return fmt.Sprintf("%s/%s", shortLinkBase(base), link.Id)
+}
 
-	return response, nil
+func shortLinkBase(base string) string {
+	if base == "" {
+		return DefaultShortLinkURL
+	}
+	return strings.TrimSuffix(base, "/")
 }
 
-// ToShortLink generates a shortened URL string using the unique
-// identifier from the provided ShortLinkResponse.
-func ToShortLink(link ShortLinkResponse) string {
-	return fmt.Sprintf("https://b.nodekit.run/%s", link.Id)
+// escapeWizardIndexes escapes the indexes of a transaction wizard query
+func escapeWizardIndexes(query string) string {
+	return strings.Replace(query, "[0]", url.QueryEscape("[0]"), -1)
 }
@@ -6,14 +6,16 @@ import (
 	"fmt"
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
 	"io"
 	"net/http"
 	"testing"
 )
 
-func Test_ToLoraDeeplink(t *testing.T) {
-	link, err := ToLoraDeepLink("tuinet-v1", true, true, api.ParticipationKey{
+func Test_ToWalletLink(t *testing.T) {
+	localnet := DefaultNetworks.Find("", "tuinet-v1", false)
+	link, err := ToWalletLink(localnet, true, IncentiveFee, api.ParticipationKey{
 		Address:             "ABC",
 		EffectiveFirstValid: nil,
 		EffectiveLastValid:  nil,
@@ -30,16 +32,15 @@ func Test_ToLoraDeeplink(t *testing.T) {
 		t.Error("Link should be a known deeplink")
 	}
 
-	// TODO put back
-	// link, err = ToLoraDeepLink("tuinet-v1", false, true, mock.Keys[0])
-	// if err != nil {
-	// 	t.Error(err)
-	// }
-	// if link != "https://lora.algokit.io/localnet/transaction-wizard?type%5B0%5D=keyreg&sender%5B0%5D=ABC&selkey%5B0%5D=VEVTVEtFWQ&sprfkey%5B0%5D=VEVTVEtFWQ&votekey%5B0%5D=VEVTVEtFWQ&votefst%5B0%5D=0&votelst%5B0%5D=30000&votekd%5B0%5D=100&fee%5B0%5D=2000000" {
-	// 	t.Error("Link should be a known deeplink fee")
-	// }
+	link, err = ToWalletLink(localnet, false, IncentiveFee, mock.Keys[0])
+	if err != nil {
+		t.Error(err)
+	}
+	if link != "https://lora.algokit.io/localnet/transaction-wizard?type%5B0%5D=keyreg&sender%5B0%5D=ABC&selkey%5B0%5D=VEVTVEtFWQ&sprfkey%5B0%5D=VEVTVEtFWQ&votekey%5B0%5D=VEVTVEtFWQ&votefst%5B0%5D=0&votelst%5B0%5D=30000&votekd%5B0%5D=100&fee%5B0%5D=2000000" {
+		t.Error("Link should be a known deeplink fee")
+	}
 
-	link, err = ToLoraDeepLink("tuinet-v1", false, false, mock.Keys[0])
+	link, err = ToWalletLink(localnet, false, 0, mock.Keys[0])
 	if err != nil {
 		t.Error(err)
 	}
@@ -47,18 +48,24 @@ func Test_ToLoraDeeplink(t *testing.T) {
 		t.Error("Link should be a known deeplink fee")
 	}
 
+	_, err = ToWalletLink(DefaultNetworks.Find("", "custom-v1", false), true, 0, mock.Keys[0])
+	if err == nil {
+		t.Error("expected an unknown network to have no wallet link")
+	}
 }
 
 func Test_ListParticipationKeys(t *testing.T) {
 	ctx := context.Background()
-	client, err := api.NewClientWithResponses("https://mainnet-api.4160.nodely.dev:443")
+	algod := fake.New()
+	defer algod.Close()
+	client, err := api.NewClientWithResponses(algod.URL)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	_, err = GetPartKeys(ctx, client)
 
-	// Expect unauthorized for Urtho servers
+	// Expect unauthorized without the admin token
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -76,14 +83,16 @@ func Test_ListParticipationKeys(t *testing.T) {
 
 func Test_ReadParticipationKey(t *testing.T) {
 	ctx := context.Background()
-	client, err := api.NewClientWithResponses("https://mainnet-api.4160.nodely.dev:443")
+	algod := fake.New()
+	defer algod.Close()
+	client, err := api.NewClientWithResponses(algod.URL)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	_, err = ReadPartKey(ctx, client, "unknown")
 
-	// Expect unauthorized for Urtho servers
+	// Expect unauthorized without the admin token
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -110,7 +119,9 @@ func Test_GenerateParticipationKey(t *testing.T) {
 	ctx := context.Background()
 
 	// Create Client
-	client, err := api.NewClientWithResponses("https://mainnet-api.4160.nodely.dev:443")
+	algod := fake.New()
+	defer algod.Close()
+	client, err := api.NewClientWithResponses(algod.URL)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -241,7 +252,7 @@ func (testOnlineShortner) Post(url string, bodyType string, body io.Reader) (res
 	}, nil
 }
 func Test_ToOnlineShortLink(t *testing.T) {
-	link, err := GetOnlineShortLink(new(testOnlineShortner), OnlineShortLinkBody{
+	link, err := GetOnlineShortLink(new(testOnlineShortner), "", OnlineShortLinkBody{
 		Account:          "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE",
 		VoteKeyB64:       "WWHePYtNZ2T3sHkqdd/38EvoFWrnIKPrTo6xN/4T1l4=",
 		SelectionKeyB64:  "e4kBLu7zXOorjLVzJHOiAn+IhOBsYBCqqHKaJCiCdJs=",
@@ -288,7 +299,7 @@ func (testOfflineShortner) Post(url string, bodyType string, body io.Reader) (re
 	}, nil
 }
 func Test_ToOfflineShortLink(t *testing.T) {
-	link, err := GetOfflineShortLink(new(testOfflineShortner), OfflineShortLinkBody{
+	link, err := GetOfflineShortLink(new(testOfflineShortner), "", OfflineShortLinkBody{
 		Account: "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE",
 		Network: "mainnet",
 	})
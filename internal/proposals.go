@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// MaxProposalTimeline is how many proposals are kept for each account
+const MaxProposalTimeline = 100
+
+// maxProposalBatch limits the blocks read in one update when the node moved several rounds at once,
+// the next updates read the rest
+const maxProposalBatch = 10
+
+// Proposals are the blocks proposed by an account
+type Proposals struct {
+	// Count is every proposal seen, including those dropped from the Timeline
+	Count int
+	// Payouts is the sum of the proposer payouts in microAlgos
+	Payouts int
+	// Timeline holds the latest proposals, oldest first
+	Timeline []Proposal
+}
+
+// Add counts a proposal, dropping the oldest one from a full Timeline
+func (p Proposals) Add(proposal Proposal) Proposals {
+	p.Count++
+	p.Payouts += proposal.Payout
+	timeline := append(p.Clone().Timeline, proposal)
+	if len(timeline) > MaxProposalTimeline {
+		timeline = timeline[len(timeline)-MaxProposalTimeline:]
+	}
+	p.Timeline = timeline
+	return p
+}
+
+// Last returns the latest proposal
+func (p Proposals) Last() (Proposal, bool) {
+	if len(p.Timeline) == 0 {
+		return Proposal{}, false
+	}
+	return p.Timeline[len(p.Timeline)-1], true
+}
+
+// Clone copies the Timeline
+func (p Proposals) Clone() Proposals {
+	if p.Timeline != nil {
+		p.Timeline = append([]Proposal{}, p.Timeline...)
+	}
+	return p
+}
+
+// ProposalsFromRecords rebuilds the proposals of every account from the ProposalRecord of a Store
+func ProposalsFromRecords(records []Record) map[string]Proposals {
+	proposals := make(map[string]Proposals)
+	for _, record := range records {
+		if record.Kind != ProposalRecord {
+			continue
+		}
+		proposals[record.Address] = proposals[record.Address].Add(Proposal{
+			Round:    record.Round,
+			Proposer: record.Address,
+			Payout:   record.Payout,
+			Time:     record.Time,
+		})
+	}
+	return proposals
+}
+
+// UpdateProposals reads up to maxProposalBatch blocks after the scanned round and counts those proposed by the accounts.
+// It returns the last round scanned, the rounds after it are read on the next call
+func (s *StateModel) UpdateProposals(ctx context.Context, client api.ClientWithResponsesInterface, scanned uint64) (uint64, error) {
+	if len(s.Accounts) == 0 || s.Status.LastRound <= scanned {
+		return max(scanned, s.Status.LastRound), nil
+	}
+	last := min(s.Status.LastRound, scanned+maxProposalBatch)
+	for round := scanned + 1; round <= last; round++ {
+		proposal, err := GetBlockProposal(ctx, client, round)
+		if err != nil {
+			return round - 1, err
+		}
+		if _, ok := s.Accounts[proposal.Proposer]; !ok {
+			continue
+		}
+		if s.Proposals == nil {
+			s.Proposals = make(map[string]Proposals)
+		}
+		s.Proposals[proposal.Proposer] = s.Proposals[proposal.Proposer].Add(*proposal)
+	}
+	return last, nil
+}
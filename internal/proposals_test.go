@@ -0,0 +1,172 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_Proposals(t *testing.T) {
+	var proposals Proposals
+	for round := 1; round <= MaxProposalTimeline+5; round++ {
+		proposals = proposals.Add(Proposal{Round: uint64(round), Payout: 10})
+	}
+	if proposals.Count != MaxProposalTimeline+5 || proposals.Payouts != (MaxProposalTimeline+5)*10 {
+		t.Errorf("expected every proposal to be counted, got %d and %d", proposals.Count, proposals.Payouts)
+	}
+	if len(proposals.Timeline) != MaxProposalTimeline || proposals.Timeline[0].Round != 6 {
+		t.Errorf("expected the latest proposals, got %d from %d", len(proposals.Timeline), proposals.Timeline[0].Round)
+	}
+	if last, _ := proposals.Last(); last.Round != MaxProposalTimeline+5 {
+		t.Errorf("expected the last round, got %d", last.Round)
+	}
+
+	// Adding does not change the previous value
+	small := Proposals{}.Add(Proposal{Round: 1})
+	_ = small.Add(Proposal{Round: 2})
+	if len(small.Timeline) != 1 {
+		t.Errorf("expected the timeline to be copied, got %+v", small.Timeline)
+	}
+
+	records := []Record{
+		{Time: time.Unix(1000, 0), Kind: ProposalRecord, Address: "ABC", Round: 10, Payout: 5},
+		{Time: time.Unix(1001, 0), Kind: RoundRecord, Round: 11},
+		{Time: time.Unix(1002, 0), Kind: ProposalRecord, Address: "ABC", Round: 12, Payout: 7},
+	}
+	restored := ProposalsFromRecords(records)
+	if restored["ABC"].Count != 2 || restored["ABC"].Payouts != 12 || restored["ABC"].Timeline[1].Round != 12 {
+		t.Errorf("unexpected proposals %+v", restored)
+	}
+}
+
+func Test_GetBlockProposal(t *testing.T) {
+	address := fake.Address("proposer")
+	algod := fake.New(fake.WithRound(1), fake.WithScenarios(fake.BlockProposedAt(2, address)))
+	defer algod.Close()
+	client := algod.Client()
+	// Produce the proposed block
+	_, err := client.WaitForBlockWithResponse(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposal, err := GetBlockProposal(context.Background(), client, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposal.Proposer != address || proposal.Payout != algod.ProposerPayout || proposal.Time.Unix() != fake.GenesisTimestamp+6 {
+		t.Errorf("unexpected proposal %+v", proposal)
+	}
+	proposal, err = GetBlockProposal(context.Background(), client, 1)
+	if err != nil || proposal.Proposer != "" {
+		t.Errorf("expected no proposer, got %+v (%v)", proposal, err)
+	}
+	if _, err = GetBlockProposal(context.Background(), client, 1000); err == nil {
+		t.Error("expected an error for a missing block")
+	}
+}
+
+func Test_UpdateProposals(t *testing.T) {
+	address := fake.Address("scanned")
+	algod := fake.New(fake.WithRound(10), fake.WithScenarios(fake.BlockProposedAt(13, address)))
+	defer algod.Close()
+	client := algod.Client()
+	algod.Advance(5)
+	algod.FailBlock(12)
+
+	state := StateModel{
+		Status:   StatusModel{LastRound: 15},
+		Accounts: map[string]Account{address: {Address: address}},
+	}
+	scanned, err := state.UpdateProposals(context.Background(), client, 10)
+	if err == nil || scanned != 11 {
+		t.Errorf("expected the scan to stop before round 12, got %d (%v)", scanned, err)
+	}
+	scanned, err = state.UpdateProposals(context.Background(), client, scanned)
+	if err != nil || scanned != 15 || state.Proposals[address].Count != 1 {
+		t.Errorf("expected the proposal of round 13 after the retry, got %d %+v (%v)", scanned, state.Proposals, err)
+	}
+}
+
+func Test_UpdateProposals_Batches(t *testing.T) {
+	address := fake.Address("batches")
+	algod := fake.New(fake.WithRound(10), fake.WithScenarios(
+		fake.BlockProposedAt(12, address),
+		fake.BlockProposedAt(33, address),
+	))
+	defer algod.Close()
+	client := algod.Client()
+	algod.Advance(25)
+
+	// The node moved 25 rounds at once, every block is read over the next updates
+	state := StateModel{
+		Status:   StatusModel{LastRound: 35},
+		Accounts: map[string]Account{address: {Address: address}},
+	}
+	scanned := uint64(10)
+	for _, expected := range []uint64{20, 30, 35} {
+		var err error
+		scanned, err = state.UpdateProposals(context.Background(), client, scanned)
+		if err != nil || scanned != expected {
+			t.Fatalf("expected the batch to end at round %d, got %d (%v)", expected, scanned, err)
+		}
+	}
+	if proposals := state.Proposals[address]; proposals.Count != 2 || proposals.Timeline[1].Round != 33 {
+		t.Errorf("expected the proposals of rounds 12 and 33, got %+v", proposals)
+	}
+}
+
+func Test_WatcherProposals(t *testing.T) {
+	address := fake.Address("watcher-proposer")
+	algod := fake.New(
+		fake.WithRound(300),
+		fake.WithRoundTime(time.Millisecond*20),
+		fake.WithKeys(api.ParticipationKey{
+			Address: address,
+			Id:      "proposer-key",
+			Key:     api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 10000, VoteKeyDilution: 100},
+		}),
+		fake.WithScenarios(
+			fake.AccountOnlineAt(301, address),
+			fake.BlockProposedAt(304, address),
+		),
+	)
+	defer algod.Close()
+	client := algod.Client()
+	// A failed block is read again without taking the node down
+	algod.FailBlock(304)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	watcher := NewWatcher(&StateModel{
+		Status: StatusModel{Version: "v3.0.0-stable", State: StableState},
+		Client: client,
+	}, client)
+	updates, _ := watcher.Subscribe(16)
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	for update := range updates {
+		for _, event := range update.Events {
+			if _, ok := event.(NodeDown); ok {
+				t.Fatalf("expected the node to stay up, got %v", update.Err)
+			}
+			proposed, ok := event.(BlockProposed)
+			if !ok {
+				continue
+			}
+			if proposed.Address != address || proposed.Round != 304 || proposed.Payout != algod.ProposerPayout {
+				t.Errorf("unexpected proposal %+v", proposed)
+			}
+			if update.State.Proposals[address].Count != 1 || update.State.Proposals[address].Payouts != algod.ProposerPayout {
+				t.Errorf("expected the proposal to be counted, got %+v", update.State.Proposals[address])
+			}
+			return
+		}
+	}
+	t.Fatal("expected a proposal before the watcher stopped")
+}
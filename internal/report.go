@@ -0,0 +1,408 @@
+package internal
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SyncGapThreshold is the time without a RoundRecord reported as a SyncGap
+const SyncGapThreshold = time.Minute * 5
+
+// UnknownThreshold is the time without any record after which algorun is assumed not running,
+// a few RoundInterval of the Recorder
+const UnknownThreshold = time.Minute * 5
+
+// Period is a time range of a Report
+type Period struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// SyncGap is a period where no round was recorded, the node was stalled, down or not watched
+type SyncGap struct {
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	FromRound uint64    `json:"from_round"`
+	ToRound   uint64    `json:"to_round"`
+}
+
+// AccountReport summarises the participation of an account over a period
+type AccountReport struct {
+	Address string `json:"address"`
+	Status  string `json:"status"`
+	// OnlineSeconds is the time the account was online during the period
+	OnlineSeconds float64 `json:"online_seconds"`
+	// Online is the percentage of the period the account was online
+	Online float64 `json:"online"`
+	// LastVote and LastProposal are the latest rounds of the local participation keys
+	LastVote     uint64 `json:"last_vote"`
+	LastProposal uint64 `json:"last_proposal"`
+	// BlocksProposed counts the proposals seen during the period
+	BlocksProposed int `json:"blocks_proposed"`
+	// Payouts is the sum of the proposer payouts of the period in microAlgos
+	Payouts int `json:"payouts"`
+	// KeyRotations counts the participation keys installed during the period
+	KeyRotations int `json:"key_rotations"`
+}
+
+// Report summarises the node availability and account participation over a period
+type Report struct {
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Network string    `json:"network"`
+	Round   uint64    `json:"round"`
+	// Availability is the percentage of the recorded time the node could be reached,
+	// the Unknown periods where algorun was not running are excluded
+	Availability    float64 `json:"availability"`
+	DowntimeSeconds float64 `json:"downtime_seconds"`
+	UnknownSeconds  float64 `json:"unknown_seconds"`
+	// Unknown lists the periods without records, outside of the outages and stalls
+	Unknown  []Period        `json:"unknown"`
+	Outages  []Outage        `json:"outages"`
+	SyncGaps []SyncGap       `json:"sync_gaps"`
+	Accounts []AccountReport `json:"accounts"`
+}
+
+// NewReport summarises the records between from and to for the accounts of the state.
+// Records before from are used to know the state at the start of the period.
+func NewReport(state StateModel, records []Record, from time.Time, to time.Time) Report {
+	report := Report{
+		From:     from,
+		To:       to,
+		Network:  state.Status.Network,
+		Round:    state.Status.LastRound,
+		Unknown:  make([]Period, 0),
+		Outages:  make([]Outage, 0),
+		SyncGaps: make([]SyncGap, 0),
+		Accounts: make([]AccountReport, 0, len(state.Accounts)),
+	}
+	period := to.Sub(from)
+
+	var inPeriod []Record
+	var before []Record
+	for _, record := range records {
+		if record.Time.After(to) {
+			continue
+		}
+		if record.Time.Before(from) {
+			before = append(before, record)
+		} else {
+			inPeriod = append(inPeriod, record)
+		}
+	}
+
+	// Outages started before the period are cut at its start
+	var downtime time.Duration
+	for _, outage := range Outages(append(before, inPeriod...), to) {
+		if outage.End.Before(from) {
+			continue
+		}
+		if outage.Start.Before(from) {
+			outage.Start = from
+		}
+		downtime += outage.End.Sub(outage.Start)
+		report.Outages = append(report.Outages, outage)
+	}
+	report.DowntimeSeconds = downtime.Seconds()
+
+	// The node was watched while it was down or stalled, the other gaps are unknown
+	var watched []Period
+	for _, outage := range append(Outages(append(before, inPeriod...), to), Stalls(append(before, inPeriod...), to)...) {
+		watched = append(watched, Period{Start: outage.Start, End: outage.End})
+	}
+	sort.Slice(watched, func(i, j int) bool { return watched[i].Start.Before(watched[j].Start) })
+	var unknown time.Duration
+	for _, gap := range recordGaps(before, inPeriod, from, to) {
+		for _, period := range gap.Without(watched) {
+			unknown += period.End.Sub(period.Start)
+			report.Unknown = append(report.Unknown, period)
+		}
+	}
+	report.UnknownSeconds = unknown.Seconds()
+	if recorded := period - unknown; recorded > 0 {
+		report.Availability = percent(recorded-downtime, recorded)
+	}
+
+	var last *Record
+	for i, record := range inPeriod {
+		if record.Kind != RoundRecord {
+			continue
+		}
+		if last != nil && record.Time.Sub(last.Time) > SyncGapThreshold {
+			report.SyncGaps = append(report.SyncGaps, SyncGap{
+				Start:     last.Time,
+				End:       record.Time,
+				FromRound: last.Round,
+				ToRound:   record.Round,
+			})
+		}
+		last = &inPeriod[i]
+	}
+
+	addresses := make([]string, 0, len(state.Accounts))
+	for address := range state.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		account := AccountReport{Address: address, Status: state.Accounts[address].Status}
+		online := accountOnline(state.Accounts[address].Status, before, inPeriod, address, from, to)
+		account.OnlineSeconds = online.Seconds()
+		if period > 0 {
+			account.Online = percent(online, period)
+		}
+		if state.ParticipationKeys != nil {
+			for _, key := range *state.ParticipationKeys {
+				if key.Address != address {
+					continue
+				}
+				if key.LastVote != nil {
+					account.LastVote = max(account.LastVote, uint64(*key.LastVote))
+				}
+				if key.LastBlockProposal != nil {
+					account.LastProposal = max(account.LastProposal, uint64(*key.LastBlockProposal))
+				}
+			}
+		}
+		for _, record := range inPeriod {
+			if record.Address != address {
+				continue
+			}
+			switch record.Kind {
+			case ProposalRecord:
+				account.BlocksProposed++
+				account.Payouts += record.Payout
+			case KeyAddedRecord:
+				account.KeyRotations++
+			}
+		}
+		report.Accounts = append(report.Accounts, account)
+	}
+	return report
+}
+
+// recordGaps lists the parts of the period between from and to with no record for
+// more than the UnknownThreshold, including its start and its end
+func recordGaps(before []Record, records []Record, from time.Time, to time.Time) []Period {
+	var gaps []Period
+	last := from
+	if len(before) > 0 {
+		last = before[len(before)-1].Time
+	}
+	times := make([]time.Time, 0, len(records)+1)
+	for _, record := range records {
+		times = append(times, record.Time)
+	}
+	for _, next := range append(times, to) {
+		if next.Sub(last) > UnknownThreshold {
+			gaps = append(gaps, Period{Start: maxTime(last, from), End: next})
+		}
+		last = next
+	}
+	return gaps
+}
+
+// Stalls pairs the NodeStalledRecord of ordered records with the record ending them,
+// like Outages. A stall without resume ends at end
+func Stalls(records []Record, end time.Time) []Outage {
+	var stalls []Outage
+	var current *Outage
+	for _, record := range records {
+		switch record.Kind {
+		case NodeStalledRecord:
+			if current == nil {
+				current = &Outage{Start: record.Time}
+			}
+		case NodeResumedRecord, RoundRecord, NodeDownRecord:
+			if current != nil {
+				current.End = record.Time
+				stalls = append(stalls, *current)
+				current = nil
+			}
+		}
+	}
+	if current != nil {
+		current.End = end
+		current.Ongoing = true
+		stalls = append(stalls, *current)
+	}
+	return stalls
+}
+
+// Without removes the periods from p, they are ordered by start
+func (p Period) Without(periods []Period) []Period {
+	var rest []Period
+	start := p.Start
+	for _, period := range periods {
+		if !period.End.After(start) || !period.Start.Before(p.End) {
+			continue
+		}
+		if period.Start.After(start) {
+			rest = append(rest, Period{Start: start, End: period.Start})
+		}
+		start = maxTime(start, period.End)
+	}
+	if p.End.After(start) {
+		rest = append(rest, Period{Start: start, End: p.End})
+	}
+	return rest
+}
+
+func maxTime(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// accountOnline adds up the time an account was online between from and to,
+// the current status is used when no change was recorded
+func accountOnline(current string, before []Record, records []Record, address string, from time.Time, to time.Time) time.Duration {
+	var changes []Record
+	for _, record := range records {
+		if record.Kind == AccountStatusRecord && record.Address == address {
+			changes = append(changes, record)
+		}
+	}
+	status := current
+	if len(changes) > 0 {
+		status = changes[0].Previous
+	}
+	for _, record := range before {
+		if record.Kind == AccountStatusRecord && record.Address == address {
+			status = record.Status
+		}
+	}
+
+	var online time.Duration
+	start := from
+	for _, change := range changes {
+		if status == "Online" {
+			online += change.Time.Sub(start)
+		}
+		status = change.Status
+		start = change.Time
+	}
+	if status == "Online" {
+		online += to.Sub(start)
+	}
+	return online
+}
+
+func percent(part time.Duration, total time.Duration) float64 {
+	return float64(part) / float64(total) * 100
+}
+
+// formatDuration rounds a number of seconds for the reports
+func formatDuration(seconds float64) string {
+	return (time.Duration(seconds * float64(time.Second))).Round(time.Second).String()
+}
+
+const reportTimeFormat = "2006-01-02 15:04 MST"
+
+// WriteMarkdown writes the report as Markdown tables
+func (r Report) WriteMarkdown(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "# Algorun report\n\n"+
+		"- Period: %s to %s\n"+
+		"- Network: %s\n"+
+		"- Round: %d\n\n",
+		r.From.UTC().Format(reportTimeFormat), r.To.UTC().Format(reportTimeFormat), r.Network, r.Round)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "## Node availability\n\n"+
+		"| Availability | Downtime | Unknown | Outages | Sync gaps |\n"+
+		"|---|---|---|---|---|\n"+
+		"| %.2f%% | %s | %s | %d | %d |\n",
+		r.Availability, formatDuration(r.DowntimeSeconds), formatDuration(r.UnknownSeconds), len(r.Outages), len(r.SyncGaps))
+	if err != nil {
+		return err
+	}
+	if len(r.Unknown) > 0 {
+		_, _ = fmt.Fprint(w, "\nThe availability excludes the unknown time, when algorun was not recording.\n"+
+			"\n### Unknown\n\n| Start | End | Duration |\n|---|---|---|\n")
+		for _, period := range r.Unknown {
+			_, _ = fmt.Fprintf(w, "| %s | %s | %s |\n",
+				period.Start.UTC().Format(reportTimeFormat), period.End.UTC().Format(reportTimeFormat),
+				formatDuration(period.End.Sub(period.Start).Seconds()))
+		}
+	}
+	if len(r.Outages) > 0 {
+		_, _ = fmt.Fprint(w, "\n### Outages\n\n| Start | End | Duration |\n|---|---|---|\n")
+		for _, outage := range r.Outages {
+			end := outage.End.UTC().Format(reportTimeFormat)
+			if outage.Ongoing {
+				end = "ongoing"
+			}
+			_, _ = fmt.Fprintf(w, "| %s | %s | %s |\n",
+				outage.Start.UTC().Format(reportTimeFormat), end, formatDuration(outage.End.Sub(outage.Start).Seconds()))
+		}
+	}
+	if len(r.SyncGaps) > 0 {
+		_, _ = fmt.Fprint(w, "\n### Sync gaps\n\n| Start | End | Rounds | Duration |\n|---|---|---|---|\n")
+		for _, gap := range r.SyncGaps {
+			_, _ = fmt.Fprintf(w, "| %s | %s | %d-%d | %s |\n",
+				gap.Start.UTC().Format(reportTimeFormat), gap.End.UTC().Format(reportTimeFormat),
+				gap.FromRound, gap.ToRound, formatDuration(gap.End.Sub(gap.Start).Seconds()))
+		}
+	}
+
+	_, err = fmt.Fprint(w, "\n## Accounts\n\n"+
+		"| Address | Status | Online | Last vote | Last proposal | Blocks proposed | Payouts | Key rotations |\n"+
+		"|---|---|---|---|---|---|---|---|\n")
+	if err != nil {
+		return err
+	}
+	for _, account := range r.Accounts {
+		_, err = fmt.Fprintf(w, "| %s | %s | %.2f%% (%s) | %d | %d | %d | %s ALGO | %d |\n",
+			account.Address, account.Status, account.Online, formatDuration(account.OnlineSeconds),
+			account.LastVote, account.LastProposal, account.BlocksProposed, FormatAlgos(account.Payouts), account.KeyRotations)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes a row per account, repeating the node availability on every row
+func (r Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{
+		"from", "to", "network", "round", "availability", "downtime_seconds", "unknown_seconds", "outages", "sync_gaps",
+		"address", "status", "online", "online_seconds", "last_vote", "last_proposal", "blocks_proposed", "payouts", "key_rotations",
+	})
+	node := []string{
+		r.From.UTC().Format(time.RFC3339),
+		r.To.UTC().Format(time.RFC3339),
+		r.Network,
+		strconv.FormatUint(r.Round, 10),
+		strconv.FormatFloat(r.Availability, 'f', 2, 64),
+		strconv.FormatFloat(r.DowntimeSeconds, 'f', 0, 64),
+		strconv.FormatFloat(r.UnknownSeconds, 'f', 0, 64),
+		strconv.Itoa(len(r.Outages)),
+		strconv.Itoa(len(r.SyncGaps)),
+	}
+	if len(r.Accounts) == 0 {
+		_ = writer.Write(append(node, make([]string, 9)...))
+	}
+	for _, account := range r.Accounts {
+		_ = writer.Write(append(node[:len(node):len(node)],
+			account.Address,
+			account.Status,
+			strconv.FormatFloat(account.Online, 'f', 2, 64),
+			strconv.FormatFloat(account.OnlineSeconds, 'f', 0, 64),
+			strconv.FormatUint(account.LastVote, 10),
+			strconv.FormatUint(account.LastProposal, 10),
+			strconv.Itoa(account.BlocksProposed),
+			strconv.Itoa(account.Payouts),
+			strconv.Itoa(account.KeyRotations),
+		))
+	}
+	writer.Flush()
+	return writer.Error()
+}
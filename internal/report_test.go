@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+func Test_NewReport(t *testing.T) {
+	from := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour * 10)
+	vote, proposal := 990, 950
+	state := StateModel{
+		Status: StatusModel{Network: "testnet-v1.0", LastRound: 1000},
+		Accounts: map[string]Account{
+			"ABC": {Address: "ABC", Status: "Online"},
+			"DEF": {Address: "DEF", Status: "Offline"},
+		},
+		ParticipationKeys: &[]api.ParticipationKey{
+			{Id: "1", Address: "ABC", LastVote: &vote, LastBlockProposal: &proposal},
+			{Id: "2", Address: "ABC"},
+		},
+	}
+	records := []Record{
+		// The node went down before the period and DEF went online
+		{Time: from.Add(-time.Hour), Kind: NodeDownRecord},
+		{Time: from.Add(-time.Hour), Kind: AccountStatusRecord, Address: "DEF", Previous: "Offline", Status: "Online"},
+		{Time: from.Add(time.Hour), Kind: NodeRecoveredRecord, Round: 100},
+		{Time: from.Add(time.Hour), Kind: RoundRecord, Round: 100},
+		{Time: from.Add(time.Hour + time.Minute), Kind: RoundRecord, Round: 120},
+		// The node stalls without records until 3h
+		{Time: from.Add(time.Hour + time.Minute*2), Kind: NodeStalledRecord, Round: 120},
+		{Time: from.Add(time.Hour * 2), Kind: AccountStatusRecord, Address: "ABC", Previous: "Offline", Status: "Online"},
+		{Time: from.Add(time.Hour * 3), Kind: NodeResumedRecord, Round: 2000},
+	}
+	// A round every minute while algorun runs
+	rounds := func(start time.Duration, end time.Duration, round uint64) {
+		for at := start; at <= end; at += time.Minute {
+			records = append(records, Record{Time: from.Add(at), Kind: RoundRecord, Round: round})
+			round += 20
+		}
+	}
+	rounds(time.Hour*3, time.Hour*4, 2000)
+	records = append(records, Record{Time: from.Add(time.Hour*4 + time.Second), Kind: ProposalRecord, Address: "ABC", Round: 2500, Payout: 1500000})
+	rounds(time.Hour*4+time.Minute, time.Hour*5, 3220)
+	records = append(records,
+		Record{Time: from.Add(time.Hour * 5), Kind: KeyAddedRecord, Address: "ABC", Key: "2"},
+		Record{Time: from.Add(time.Hour * 5), Kind: AccountStatusRecord, Address: "DEF", Previous: "Online", Status: "Offline"},
+	)
+	// algorun is not running between 5h and 7h
+	rounds(time.Hour*7, time.Hour*9-time.Minute, 7000)
+	records = append(records,
+		Record{Time: from.Add(time.Hour * 9), Kind: NodeDownRecord},
+		// Records after the period are ignored
+		Record{Time: to.Add(time.Hour), Kind: ProposalRecord, Address: "ABC", Round: 9000},
+	)
+
+	report := NewReport(state, records, from, to)
+	if report.Network != "testnet-v1.0" || report.Round != 1000 {
+		t.Errorf("unexpected network %s at %d", report.Network, report.Round)
+	}
+	if len(report.Outages) != 2 || !report.Outages[0].Start.Equal(from) || !report.Outages[1].Ongoing {
+		t.Errorf("expected the outages cut by the period, got %+v", report.Outages)
+	}
+	// The availability is measured over the 8h recorded
+	if report.DowntimeSeconds != 7200 || report.UnknownSeconds != 7200 || report.Availability != 75 {
+		t.Errorf("expected 2h of downtime, 2h unknown and 75%% availability, got %v, %v and %v",
+			report.DowntimeSeconds, report.UnknownSeconds, report.Availability)
+	}
+	if len(report.Unknown) != 1 || !report.Unknown[0].Start.Equal(from.Add(time.Hour*5)) || !report.Unknown[0].End.Equal(from.Add(time.Hour*7)) {
+		t.Errorf("expected the time algorun was not running as unknown, got %+v", report.Unknown)
+	}
+	if len(report.SyncGaps) != 2 || report.SyncGaps[0].FromRound != 120 || report.SyncGaps[0].ToRound != 2000 {
+		t.Errorf("expected the stall and the unknown time as sync gaps, got %+v", report.SyncGaps)
+	}
+
+	if len(report.Accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %+v", report.Accounts)
+	}
+	abc, def := report.Accounts[0], report.Accounts[1]
+	if abc.Address != "ABC" || abc.OnlineSeconds != 8*3600 || abc.Online != 80 {
+		t.Errorf("expected ABC online for 8h, got %+v", abc)
+	}
+	if abc.LastVote != 990 || abc.LastProposal != 950 || abc.BlocksProposed != 1 || abc.Payouts != 1500000 || abc.KeyRotations != 1 {
+		t.Errorf("unexpected participation %+v", abc)
+	}
+	if def.OnlineSeconds != 5*3600 || def.Status != "Offline" {
+		t.Errorf("expected DEF online for 5h, got %+v", def)
+	}
+
+	// Without records the availability is unknown and the current status is used
+	empty := NewReport(state, nil, from, to)
+	if empty.Availability != 0 || empty.UnknownSeconds != 36000 || empty.Accounts[0].Online != 100 || empty.Accounts[1].Online != 0 {
+		t.Errorf("unexpected empty report %+v", empty)
+	}
+}
+
+func Test_PeriodWithout(t *testing.T) {
+	at := func(hours int) time.Time {
+		return time.Date(2024, 11, 1, hours, 0, 0, 0, time.UTC)
+	}
+	rest := Period{Start: at(1), End: at(10)}.Without([]Period{
+		{Start: at(0), End: at(2)},
+		{Start: at(4), End: at(5)},
+		{Start: at(4), End: at(6)},
+		{Start: at(11), End: at(12)},
+	})
+	expected := []Period{{Start: at(2), End: at(4)}, {Start: at(6), End: at(10)}}
+	if len(rest) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, rest)
+	}
+	for i := range expected {
+		if !rest[i].Start.Equal(expected[i].Start) || !rest[i].End.Equal(expected[i].End) {
+			t.Errorf("expected %+v, got %+v", expected[i], rest[i])
+		}
+	}
+}
+
+func Test_ReportWriters(t *testing.T) {
+	from := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	report := Report{
+		From:            from,
+		To:              from.Add(time.Hour),
+		Network:         "testnet-v1.0",
+		Round:           1000,
+		Availability:    99.5,
+		DowntimeSeconds: 18,
+		UnknownSeconds:  60,
+		Unknown:         []Period{{Start: from.Add(time.Minute), End: from.Add(time.Minute * 2)}},
+		Outages:         []Outage{{Start: from, End: from.Add(time.Second * 18)}},
+		Accounts: []AccountReport{
+			{Address: "ABC", Status: "Online", Online: 50, OnlineSeconds: 1800, LastVote: 990, BlocksProposed: 2, Payouts: 2500000},
+		},
+	}
+
+	var md bytes.Buffer
+	err := report.WriteMarkdown(&md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"- Period: 2024-11-01 00:00 UTC to 2024-11-01 01:00 UTC",
+		"| 99.50% | 18s | 1m0s | 1 | 0 |",
+		"| 2024-11-01 00:01 UTC | 2024-11-01 00:02 UTC | 1m0s |",
+		"| 2024-11-01 00:00 UTC | 2024-11-01 00:00 UTC | 18s |",
+		"| ABC | Online | 50.00% (30m0s) | 990 | 0 | 2 | 2.5 ALGO | 0 |",
+	} {
+		if !strings.Contains(md.String(), expected) {
+			t.Errorf("expected %q in\n%s", expected, md.String())
+		}
+	}
+
+	var out bytes.Buffer
+	err = report.WriteCSV(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || len(rows[1]) != len(rows[0]) || rows[1][9] != "ABC" || rows[1][4] != "99.50" {
+		t.Errorf("unexpected csv %v", rows)
+	}
+
+	report.Accounts = nil
+	out.Reset()
+	_ = report.WriteCSV(&out)
+	rows, _ = csv.NewReader(&out).ReadAll()
+	if len(rows) != 2 || rows[1][9] != "" {
+		t.Errorf("expected the node row without accounts, got %v", rows)
+	}
+}
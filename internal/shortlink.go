@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+// shortLinkIdLength is the number of base32 characters of a short link id
+const shortLinkIdLength = 13
+
+// loraNetworkPattern matches the network names of Lora
+var loraNetworkPattern = regexp.MustCompile("^[a-z0-9]+$")
+
+// ShortLinkServer implements the short link protocol: POST /online and /offline return the id
+// of the Lora link of the body and GET /{id} redirects to it. Links are kept in memory,
+// their id is derived from the link so a client gets the same id after a restart.
+type ShortLinkServer struct {
+	mu    sync.RWMutex
+	links map[string]string
+}
+
+// NewShortLinkServer creates an empty ShortLinkServer
+func NewShortLinkServer() *ShortLinkServer {
+	return &ShortLinkServer{links: make(map[string]string)}
+}
+
+// Handler routes the requests of the protocol
+func (s *ShortLinkServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /online", s.online)
+	mux.HandleFunc("POST /offline", s.offline)
+	mux.HandleFunc("GET /{id}", s.redirect)
+	return mux
+}
+
+// Add stores a link and returns its id
+func (s *ShortLinkServer) Add(link string) string {
+	hash := sha256.Sum256([]byte(link))
+	id := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(hash[:])[:shortLinkIdLength]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.links[id] = link
+	return id
+}
+
+// Get finds the link of an id
+func (s *ShortLinkServer) Get(id string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	link, ok := s.links[id]
+	return link, ok
+}
+
+func (s *ShortLinkServer) online(w http.ResponseWriter, r *http.Request) {
+	var body OnlineShortLinkBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err == nil {
+		err = validateShortLink(body.Account, body.Network)
+	}
+	if err == nil && (body.VoteKeyB64 == "" || body.SelectionKeyB64 == "" || body.VoteLastValid <= body.VoteFirstValid) {
+		err = errors.New("invalid participation key")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeShortLink(w, s.Add(body.LoraDeepLink()))
+}
+
+func (s *ShortLinkServer) offline(w http.ResponseWriter, r *http.Request) {
+	var body OfflineShortLinkBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err == nil {
+		err = validateShortLink(body.Account, body.Network)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeShortLink(w, s.Add(body.LoraDeepLink()))
+}
+
+func (s *ShortLinkServer) redirect(w http.ResponseWriter, r *http.Request) {
+	link, ok := s.Get(r.PathValue("id"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	http.Redirect(w, r, link, http.StatusFound)
+}
+
+func validateShortLink(account string, network string) error {
+	if !ValidateAddress(account) {
+		return errors.New("invalid account")
+	}
+	if !loraNetworkPattern.MatchString(network) {
+		return errors.New("invalid network")
+	}
+	return nil
+}
+
+func writeShortLink(w http.ResponseWriter, id string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(ShortLinkResponse{Id: id})
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+)
+
+func Test_ShortLinkServer(t *testing.T) {
+	server := httptest.NewServer(NewShortLinkServer().Handler())
+	defer server.Close()
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	address := "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE"
+	part := mock.Keys[0]
+	part.Address = address
+
+	localnet := DefaultNetworks.Find("", "tuinet-v1.0", false)
+	online := NewOnlineShortLinkBody(localnet, part)
+	online.Fee = IncentiveFee
+	link, err := GetOnlineShortLink(new(HttpPkg), server.URL+"/", online)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(link.Id) != shortLinkIdLength || ToShortLink(server.URL, link) != server.URL+"/"+link.Id {
+		t.Fatalf("unexpected short link %+v", link)
+	}
+	again, _ := GetOnlineShortLink(new(HttpPkg), server.URL, online)
+	if again.Id != link.Id {
+		t.Errorf("expected the same id for the same link, got %s and %s", link.Id, again.Id)
+	}
+	res, err := client.Get(ToShortLink(server.URL, link))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := ToWalletLink(localnet, false, IncentiveFee, part)
+	if res.StatusCode != http.StatusFound || res.Header.Get("Location") != expected {
+		t.Errorf("expected a redirect to %s, got %d %s", expected, res.StatusCode, res.Header.Get("Location"))
+	}
+
+	offline, err := GetOfflineShortLink(new(HttpPkg), server.URL, OfflineShortLinkBody{Account: address, Network: "localnet"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, _ = client.Get(ToShortLink(server.URL, offline))
+	expected, _ = ToWalletLink(localnet, true, 0, part)
+	if res.Header.Get("Location") != expected {
+		t.Errorf("expected a redirect to %s, got %s", expected, res.Header.Get("Location"))
+	}
+
+	_, err = GetOfflineShortLink(new(HttpPkg), server.URL, OfflineShortLinkBody{Account: "ABC", Network: "localnet"})
+	if err == nil {
+		t.Error("expected an invalid account to be refused")
+	}
+	online.Network = "../mainnet"
+	_, err = GetOnlineShortLink(new(HttpPkg), server.URL, online)
+	if err == nil {
+		t.Error("expected an invalid network to be refused")
+	}
+	res, _ = client.Get(server.URL + "/MISSING")
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected an unknown id to be missing, got %d", res.StatusCode)
+	}
+}
+
+func Test_ToShortLink(t *testing.T) {
+	if link := ToShortLink("", ShortLinkResponse{Id: "1234"}); link != "https://b.nodekit.run/1234" {
+		t.Errorf("expected the default service, got %s", link)
+	}
+	if link := ToShortLink("", ShortLinkResponse{Fallback: "https://lora.algokit.io"}); link != "https://lora.algokit.io" {
+		t.Errorf("expected the fallback, got %s", link)
+	}
+}
+
+func Test_ShortLinkTimeout(t *testing.T) {
+	// The service accepts the connection and never answers
+	hang := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-hang
+	}))
+	defer server.Close()
+	defer close(hang)
+	timeout := httpClient.Timeout
+	httpClient.Timeout = time.Millisecond * 100
+	defer func() { httpClient.Timeout = timeout }()
+
+	_, err := GetOfflineShortLink(new(HttpPkg), server.URL, OfflineShortLinkBody{Account: "ABC", Network: "localnet"})
+	if err == nil {
+		t.Error("expected the request to time out")
+	}
+}
@@ -0,0 +1,148 @@
+package internal
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/kmd"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// DevSigner holds the private keys of development accounts to register keys without a wallet.
+// It refuses to sign for any network that is not a local one.
+type DevSigner struct {
+	keys map[string]ed25519.PrivateKey
+}
+
+// NewDevSigner signs with the given private keys
+func NewDevSigner(keys ...ed25519.PrivateKey) *DevSigner {
+	signer := &DevSigner{keys: make(map[string]ed25519.PrivateKey, len(keys))}
+	for _, key := range keys {
+		account, err := crypto.AccountFromPrivateKey(key)
+		if err != nil {
+			continue
+		}
+		signer.keys[account.Address.String()] = key
+	}
+	return signer
+}
+
+// LoadMnemonicSigner reads a file with one 25 word mnemonic per line, blank lines and # comments are skipped
+func LoadMnemonicSigner(path string) (*DevSigner, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var keys []ed25519.PrivateKey
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, err := mnemonic.ToPrivateKey(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mnemonic on line %d of %s: %w", line, path, err)
+		}
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no mnemonic in %s", path)
+	}
+	return NewDevSigner(keys...), nil
+}
+
+// LoadKmdSigner exports the keys of a kmd wallet, like the unencrypted-default-wallet of a localnet
+func LoadKmdSigner(address string, token string, wallet string, password string) (*DevSigner, error) {
+	client, err := kmd.MakeClient(address, token)
+	if err != nil {
+		return nil, err
+	}
+	wallets, err := client.ListWallets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the kmd wallets: %w", err)
+	}
+	id := ""
+	for _, w := range wallets.Wallets {
+		if w.Name == wallet {
+			id = w.ID
+		}
+	}
+	if id == "" {
+		return nil, fmt.Errorf("kmd wallet %s not found", wallet)
+	}
+	handle, err := client.InitWalletHandle(id, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the kmd wallet %s: %w", wallet, err)
+	}
+	defer client.ReleaseWalletHandle(handle.WalletHandleToken)
+	list, err := client.ListKeys(handle.WalletHandleToken)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]ed25519.PrivateKey, 0, len(list.Addresses))
+	for _, addr := range list.Addresses {
+		res, err := client.ExportKey(handle.WalletHandleToken, password, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", addr, err)
+		}
+		keys = append(keys, res.PrivateKey)
+	}
+	return NewDevSigner(keys...), nil
+}
+
+// Addresses lists the accounts the signer holds a key for
+func (s *DevSigner) Addresses() []string {
+	addresses := make([]string, 0, len(s.keys))
+	for address := range s.keys {
+		addresses = append(addresses, address)
+	}
+	slices.Sort(addresses)
+	return addresses
+}
+
+// CanSign is true when the signer holds the key authorizing the account
+func (s *DevSigner) CanSign(account Account) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.keys[AuthorizingAddress(account)]
+	return ok
+}
+
+// SubmitKeyreg signs an online key registration for the key paying at least fee, or an offline one
+// when the key is nil, submits it and returns its transaction id
+func (s *DevSigner) SubmitKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, network Network, account Account, key *api.ParticipationKey, fee uint64) (string, error) {
+	if !network.Local {
+		return "", fmt.Errorf("the dev signer only signs on local networks, not %s", network.Name)
+	}
+	signer := AuthorizingAddress(account)
+	sk, ok := s.keys[signer]
+	if !ok {
+		return "", fmt.Errorf("no key to sign for %s", signer)
+	}
+	params, err := GetSuggestedParams(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	txn, err := MakeKeyreg(account.Address, key, params, fee)
+	if err != nil {
+		return "", err
+	}
+	_, signed, err := crypto.SignTransaction(sk, txn)
+	if err != nil {
+		return "", err
+	}
+	return SubmitTransaction(ctx, client, signed)
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+// devMnemonic is the account imported by the docker localnet
+const devMnemonic = "artefact exist coil life turtle edge edge inside punch glance recycle teach melody diet method pause slam dumb race interest amused side learn able heavy"
+
+func Test_LoadMnemonicSigner(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "dev.mnemonic")
+	_ = os.WriteFile(path, []byte("# docker localnet\n\n"+devMnemonic+"\n"), 0600)
+	signer, err := LoadMnemonicSigner(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(signer.Addresses(), []string{"TUIDKH2C7MUHZDD77MAMUREJRKNK25SYXB7OAFA6JFBB24PEL5UX4S4GUU"}) {
+		t.Errorf("unexpected addresses %v", signer.Addresses())
+	}
+
+	_ = os.WriteFile(path, []byte("artefact exist coil\n"), 0600)
+	_, err = LoadMnemonicSigner(path)
+	if err == nil {
+		t.Error("expected an invalid mnemonic to fail")
+	}
+	_ = os.WriteFile(path, []byte("# empty\n"), 0600)
+	_, err = LoadMnemonicSigner(path)
+	if err == nil {
+		t.Error("expected a file without mnemonic to fail")
+	}
+}
+
+func Test_LoadKmdSigner(t *testing.T) {
+	accounts := []crypto.Account{crypto.GenerateAccount(), crypto.GenerateAccount()}
+	kmd := fake.NewKmd("", accounts...)
+	defer kmd.Close()
+
+	signer, err := LoadKmdSigner(kmd.URL, fake.Token, fake.KmdWallet, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, account := range accounts {
+		if !signer.CanSign(Account{Address: account.Address.String()}) {
+			t.Errorf("expected a key for %s", account.Address)
+		}
+	}
+
+	_, err = LoadKmdSigner(kmd.URL, fake.Token, "missing", "")
+	if err == nil {
+		t.Error("expected a missing wallet to fail")
+	}
+	_, err = LoadKmdSigner(kmd.URL, fake.Token, fake.KmdWallet, "wrong")
+	if err == nil {
+		t.Error("expected a wrong password to fail")
+	}
+}
+
+func Test_DevSigner_SubmitKeyreg(t *testing.T) {
+	ctx := context.Background()
+	account := crypto.GenerateAccount()
+	address := account.Address.String()
+	key := api.ParticipationKey{
+		Id:      "DEV",
+		Address: address,
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection-" + address),
+			VoteParticipationKey:      []byte("vote-" + address),
+			VoteFirstValid:            100,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+	algod := fake.New(fake.WithRound(100), fake.WithKeys(key))
+	algod.GenesisId = "tuinet-v1.0"
+	defer algod.Close()
+	client := algod.Client()
+	signer := NewDevSigner(account.PrivateKey)
+	localnet := DefaultNetworks.Find("", "tuinet-v1.0", false)
+
+	_, err := signer.SubmitKeyreg(ctx, client, DefaultNetworks.Find("", "mainnet-v1.0", false), Account{Address: address}, &key, 0)
+	if err == nil {
+		t.Fatal("expected the signer to refuse a public network")
+	}
+	_, err = signer.SubmitKeyreg(ctx, client, localnet, Account{Address: fake.Address("other")}, nil, 0)
+	if err == nil {
+		t.Fatal("expected the signer to refuse an unknown account")
+	}
+
+	txId, err := signer.SubmitKeyreg(ctx, client, localnet, Account{Address: address}, &key, IncentiveFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = WaitForConfirmation(ctx, client, txId, ConfirmationRounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rpcAccount, _ := algod.Account(address)
+	if rpcAccount.Status != "Online" || rpcAccount.Participation == nil {
+		t.Errorf("expected the key to be registered, got %+v", rpcAccount)
+	}
+	if rpcAccount.IncentiveEligible == nil || !*rpcAccount.IncentiveEligible {
+		t.Error("expected the incentive fee to be paid")
+	}
+}
@@ -2,7 +2,6 @@ package internal
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/algorandfoundation/algorun-tui/api"
@@ -14,12 +13,24 @@ type StateModel struct {
 	Metrics           MetricsModel
 	Accounts          map[string]Account
 	ParticipationKeys *[]api.ParticipationKey
+	// Proposals are the blocks proposed by the accounts, by address
+	Proposals map[string]Proposals
+	// Supply is the online stake, it is replaced and never changed so snapshots can share it
+	Supply *Supply
 
 	// Application State
 	Admin bool
 
-	// TODO: handle contexts instead of adding it to state
-	Watching bool
+	// Node is the local algod process, nil when there is no ALGORAND_DATA
+	Node *Node
+	// Signer registers keys of local networks from the TUI, nil without dev keys
+	Signer *DevSigner
+	// FeePolicy decides when online key registrations pay the incentive eligibility fee
+	FeePolicy FeePolicy
+	// ShortLinkURL is the base URL of the short link service, DefaultShortLinkURL when empty
+	ShortLinkURL string
+	// Networks finds the network of the node, DefaultNetworks when nil
+	Networks NetworkRegistry
 
 	// RPC
 	Client  api.ClientWithResponsesInterface
@@ -27,84 +38,32 @@ type StateModel struct {
 	Context context.Context
 }
 
-func (s *StateModel) waitAfterError(err error, cb func(model *StateModel, err error)) {
-	if err != nil {
-		s.Status.State = "DOWN"
-		cb(nil, err)
-		time.Sleep(time.Second * 3)
-	}
+// Network is the entry of the Networks registry for the network of the node
+func (s *StateModel) Network() Network {
+	return s.Networks.Find(s.Status.GenesisHash, s.Status.Network, s.Status.DevMode)
 }
 
-// TODO: allow context to handle loop
-func (s *StateModel) Watch(cb func(model *StateModel, err error), ctx context.Context, client api.ClientWithResponsesInterface) {
-	s.Watching = true
-	if s.Metrics.Window == 0 {
-		s.Metrics.Window = 100
+// Snapshot copies the state so it can be read while the Watcher keeps updating
+func (s *StateModel) Snapshot() StateModel {
+	snapshot := *s
+	if s.Accounts != nil {
+		snapshot.Accounts = make(map[string]Account, len(s.Accounts))
+		for address, account := range s.Accounts {
+			snapshot.Accounts[address] = account
+		}
 	}
-
-	err := s.Status.Fetch(ctx, client, new(HttpPkg))
-	if err != nil {
-		cb(nil, err)
+	if s.ParticipationKeys != nil {
+		keys := append([]api.ParticipationKey{}, *s.ParticipationKeys...)
+		snapshot.ParticipationKeys = &keys
 	}
-
-	lastRound := s.Status.LastRound
-
-	for {
-		if !s.Watching {
-			break
-		}
-
-		if s.Status.State == FastCatchupState {
-			time.Sleep(time.Second * 10)
-			err := s.Status.Fetch(ctx, client, new(HttpPkg))
-			if err != nil {
-				cb(nil, err)
-			}
-			continue
-		}
-
-		status, err := client.WaitForBlockWithResponse(ctx, int(lastRound))
-		s.waitAfterError(err, cb)
-		if err != nil {
-			continue
+	if s.Proposals != nil {
+		snapshot.Proposals = make(map[string]Proposals, len(s.Proposals))
+		for address, proposals := range s.Proposals {
+			snapshot.Proposals[address] = proposals.Clone()
 		}
-		if status.StatusCode() != 200 {
-			s.waitAfterError(errors.New(status.Status()), cb)
-			continue
-		}
-
-		s.Status.State = "Unknown"
-
-		// Update Status
-		s.Status.Update(status.JSON200.LastRound, status.JSON200.CatchupTime, status.JSON200.Catchpoint, status.JSON200.UpgradeNodeVote)
-
-		// Fetch Keys
-		s.UpdateKeys()
-
-		if s.Status.State == SyncingState {
-			lastRound = s.Status.LastRound
-			cb(s, nil)
-			continue
-		}
-		// Run Round Averages and RX/TX every 5 rounds
-		if s.Status.LastRound%5 == 0 || (s.Status.LastRound > 100 && s.Metrics.RoundTime.Seconds() == 0) {
-			bm, err := GetBlockMetrics(ctx, client, s.Status.LastRound, s.Metrics.Window)
-			s.waitAfterError(err, cb)
-			if err != nil {
-				continue
-			}
-			s.Metrics.RoundTime = bm.AvgTime
-			s.Metrics.TPS = bm.TPS
-			s.UpdateMetricsFromRPC(ctx, client)
-		}
-
-		lastRound = s.Status.LastRound
-		cb(s, nil)
 	}
-}
-
-func (s *StateModel) Stop() {
-	s.Watching = false
+	snapshot.Metrics.History = s.Metrics.History.Clone()
+	return snapshot
 }
 
 func (s *StateModel) UpdateMetricsFromRPC(ctx context.Context, client api.ClientWithResponsesInterface) {
@@ -118,12 +77,18 @@ func (s *StateModel) UpdateMetricsFromRPC(ctx context.Context, client api.Client
 		now := time.Now()
 		diff := now.Sub(s.Metrics.LastTS)
 
-		s.Metrics.TX = max(0, int(float64(res["algod_network_sent_bytes_total"]-s.Metrics.LastTX)/diff.Seconds()))
-		s.Metrics.RX = max(0, int(float64(res["algod_network_received_bytes_total"]-s.Metrics.LastRX)/diff.Seconds()))
+		sent := int(res.Sum("algod_network_sent_bytes_total", nil))
+		received := int(res.Sum("algod_network_received_bytes_total", nil))
+
+		s.Metrics.TX = max(0, int(float64(sent-s.Metrics.LastTX)/diff.Seconds()))
+		s.Metrics.RX = max(0, int(float64(received-s.Metrics.LastRX)/diff.Seconds()))
+
+		s.Metrics.Rates = res.Rates(s.Metrics.Families, diff)
+		s.Metrics.Families = res
 
 		s.Metrics.LastTS = now
-		s.Metrics.LastTX = res["algod_network_sent_bytes_total"]
-		s.Metrics.LastRX = res["algod_network_received_bytes_total"]
+		s.Metrics.LastTX = sent
+		s.Metrics.LastRX = received
 	}
 }
 func (s *StateModel) UpdateAccounts() error {
@@ -132,9 +97,9 @@ func (s *StateModel) UpdateAccounts() error {
 	return err
 }
 
-func (s *StateModel) UpdateKeys() {
+func (s *StateModel) UpdateKeys(ctx context.Context) {
 	var err error
-	s.ParticipationKeys, err = GetPartKeys(s.Context, s.Client)
+	s.ParticipationKeys, err = GetPartKeys(ctx, s.Client)
 	if err != nil {
 		s.Admin = false
 	}
@@ -3,22 +3,20 @@ package internal
 import (
 	"context"
 	"github.com/algorandfoundation/algorun-tui/api"
-	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"testing"
 	"time"
 )
 
 func Test_StateModel(t *testing.T) {
 	// Setup elevated client
-	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
-	if err != nil {
-		t.Fatal(err)
-	}
-	client, err := api.NewClientWithResponses("http://localhost:8080", api.WithRequestEditorFn(apiToken.Intercept))
+	algod := fake.New(fake.WithRound(1337))
+	defer algod.Close()
+	client := algod.Client()
 
 	state := StateModel{
-		Watching: true,
 		Status: StatusModel{
+			Version:     "v3.0.0-stable",
 			LastRound:   1337,
 			NeedsUpdate: true,
 			State:       SyncingState,
@@ -32,30 +30,136 @@ func Test_StateModel(t *testing.T) {
 		Client:  client,
 		Context: context.Background(),
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	watcher := NewWatcher(&state, client)
+	updates, _ := watcher.Subscribe(1)
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
 	count := 0
-	go state.Watch(func(model *StateModel, err error) {
-		if err != nil || model == nil {
-			t.Error("Failed")
-			return
+	var last StateModel
+	for update := range updates {
+		if update.Err != nil {
+			t.Error(update.Err)
 		}
 		count++
-	}, context.Background(), client)
-	time.Sleep(5 * time.Second)
-	// Stop the watcher
-	state.Stop()
+		last = update.State
+	}
+	// The watcher exits when the context is cancelled
+	if err := <-done; err != context.DeadlineExceeded {
+		t.Errorf("expected the deadline to stop the watcher, got %v", err)
+	}
 	if count == 0 {
 		t.Fatal("Did not receive any updates")
 	}
-	if state.Status.LastRound <= 0 {
+	if last.Status.LastRound <= 1337 {
 		t.Fatal("LastRound is stale")
 	}
+	// The original state is never mutated by the watcher
+	if state.Status.LastRound != 1337 {
+		t.Fatal("expected the state to be copied")
+	}
 	t.Log(
-		"Watching: ", state.Watching,
-		"LastRound: ", state.Status.LastRound,
-		"NeedsUpdate: ", state.Status.NeedsUpdate,
-		"State: ", state.Status.State,
-		"RoundTime: ", state.Metrics.RoundTime,
-		"RX: ", state.Metrics.RX,
-		"TX: ", state.Metrics.TX,
+		"LastRound: ", last.Status.LastRound,
+		"NeedsUpdate: ", last.Status.NeedsUpdate,
+		"State: ", last.Status.State,
+		"RoundTime: ", last.Metrics.RoundTime,
+		"RX: ", last.Metrics.RX,
+		"TX: ", last.Metrics.TX,
+	)
+}
+
+func Test_StateModelSnapshot(t *testing.T) {
+	keys := []api.ParticipationKey{{Id: "123", Address: "ABC"}}
+	state := StateModel{
+		Accounts:          map[string]Account{"ABC": {Address: "ABC", Status: "Online"}},
+		ParticipationKeys: &keys,
+	}
+	snapshot := state.Snapshot()
+	state.Accounts["ABC"] = Account{Address: "ABC", Status: "Offline"}
+	keys[0].Id = "456"
+	if snapshot.Accounts["ABC"].Status != "Online" || (*snapshot.ParticipationKeys)[0].Id != "123" {
+		t.Error("expected the snapshot to be independent of the state")
+	}
+}
+
+func Test_StateModelScenarios(t *testing.T) {
+	address := fake.Address("scenario")
+	catchpoint := "2000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA"
+	algod := fake.New(
+		fake.WithRound(100),
+		fake.WithKeys(api.ParticipationKey{
+			Address: address,
+			Id:      "scenario-key",
+			Key:     api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 10000, VoteKeyDilution: 100},
+		}),
+		fake.WithScenarios(
+			fake.AccountOnlineAt(102, address),
+			fake.NodeDownAt(105),
+		),
 	)
+	defer algod.Close()
+	client := algod.Client()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher := NewWatcher(&StateModel{
+		Status:  StatusModel{Version: "v3.0.0-stable", State: SyncingState},
+		Client:  client,
+		Context: context.Background(),
+	}, client)
+	updates, _ := watcher.Subscribe(16)
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	timeout := time.After(time.Second * 5)
+	for down := false; !down; {
+		select {
+		case update := <-updates:
+			for _, event := range update.Events {
+				if _, ok := event.(NodeDown); ok {
+					down = true
+				}
+			}
+		case <-timeout:
+			t.Fatal("expected an error when the node goes down")
+		}
+	}
+	cancel()
+	if algod.Round() != 105 {
+		t.Errorf("expected the node to stop at round 105, got %d", algod.Round())
+	}
+
+	algod.SetDown(false)
+	account, err := GetAccount(client, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Status != "Online" || account.Participation == nil {
+		t.Errorf("expected the account to be online, got %s", account.Status)
+	}
+
+	// Fast catchup progresses on every status request
+	algod.SetCatchup(&fake.Catchup{Catchpoint: catchpoint, TotalAccounts: 10, TotalKvs: 10, TotalBlocks: 10})
+	status := StatusModel{Version: "v3.0.0-stable"}
+	err = status.Fetch(context.Background(), client, new(testResponse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != FastCatchupState || status.Catchpoint.Label != catchpoint {
+		t.Errorf("expected a fast catchup, got %s", status.State)
+	}
+	for i := 0; i < 20 && status.State == FastCatchupState; i++ {
+		err = status.Fetch(context.Background(), client, new(testResponse))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if status.State == FastCatchupState || status.LastRound != 2000 {
+		t.Errorf("expected the catchup to finish at round 2000, got %s %d", status.State, status.LastRound)
+	}
 }
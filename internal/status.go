@@ -2,7 +2,9 @@ package internal
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/algorandfoundation/algorun-tui/api"
 )
@@ -15,14 +17,56 @@ const (
 	StableState      State = "RUNNING"
 )
 
+// NodeStatusResponse is the body shared by the algod.Status and algod.WaitForBlock responses
+type NodeStatusResponse = struct {
+	Catchpoint                    *string `json:"catchpoint,omitempty"`
+	CatchpointAcquiredBlocks      *int    `json:"catchpoint-acquired-blocks,omitempty"`
+	CatchpointProcessedAccounts   *int    `json:"catchpoint-processed-accounts,omitempty"`
+	CatchpointProcessedKvs        *int    `json:"catchpoint-processed-kvs,omitempty"`
+	CatchpointTotalAccounts       *int    `json:"catchpoint-total-accounts,omitempty"`
+	CatchpointTotalBlocks         *int    `json:"catchpoint-total-blocks,omitempty"`
+	CatchpointTotalKvs            *int    `json:"catchpoint-total-kvs,omitempty"`
+	CatchpointVerifiedAccounts    *int    `json:"catchpoint-verified-accounts,omitempty"`
+	CatchpointVerifiedKvs         *int    `json:"catchpoint-verified-kvs,omitempty"`
+	CatchupTime                   int     `json:"catchup-time"`
+	LastCatchpoint                *string `json:"last-catchpoint,omitempty"`
+	LastRound                     int     `json:"last-round"`
+	LastVersion                   string  `json:"last-version"`
+	NextVersion                   string  `json:"next-version"`
+	NextVersionRound              int     `json:"next-version-round"`
+	NextVersionSupported          bool    `json:"next-version-supported"`
+	StoppedAtUnsupportedRound     bool    `json:"stopped-at-unsupported-round"`
+	TimeSinceLastRound            int     `json:"time-since-last-round"`
+	UpgradeDelay                  *int    `json:"upgrade-delay,omitempty"`
+	UpgradeNextProtocolVoteBefore *int    `json:"upgrade-next-protocol-vote-before,omitempty"`
+	UpgradeNoVotes                *int    `json:"upgrade-no-votes,omitempty"`
+	UpgradeNodeVote               *bool   `json:"upgrade-node-vote,omitempty"`
+	UpgradeVoteRounds             *int    `json:"upgrade-vote-rounds,omitempty"`
+	UpgradeVotes                  *int    `json:"upgrade-votes,omitempty"`
+	UpgradeVotesRequired          *int    `json:"upgrade-votes-required,omitempty"`
+	UpgradeYesVotes               *int    `json:"upgrade-yes-votes,omitempty"`
+}
+
 // StatusModel represents a status response from algod.Status
 type StatusModel struct {
-	State       State
-	Version     string
-	Network     string
+	State   State
+	Version string
+	Network string
+	// GenesisHash is the base64 genesis hash of the network
+	GenesisHash string
+	// DevMode is true when the genesis of the network enables dev mode
+	DevMode     bool
 	Voting      bool
 	NeedsUpdate bool
 	LastRound   uint64 // Last recorded round
+	// Catchpoint is the progress of a fast catchup
+	Catchpoint CatchpointModel
+	// Upgrade is the protocol upgrade vote and schedule
+	Upgrade UpgradeModel
+	// Health is the stall and clock skew detection
+	Health HealthModel
+	// Sync is the progress of a syncing node
+	Sync SyncModel
 }
 
 // String prints the last round value
@@ -56,6 +100,10 @@ func (m *StatusModel) Fetch(ctx context.Context, client api.ClientWithResponsesI
 			return fmt.Errorf("Status code %d: %s", v.StatusCode(), v.Status())
 		}
 		m.Network = v.JSON200.GenesisId
+		m.GenesisHash = base64.StdEncoding.EncodeToString(v.JSON200.GenesisHashB64)
+		// Without the genesis the network is assumed to produce rounds on its own
+		genesis, err := GetGenesis(ctx, client)
+		m.DevMode = err == nil && genesis.DevMode
 		m.Version = fmt.Sprintf("v%d.%d.%d-%s", v.JSON200.Build.Major, v.JSON200.Build.Minor, v.JSON200.Build.BuildNumber, v.JSON200.Build.Channel)
 		currentRelease, err := GetGoAlgorandRelease(v.JSON200.Build.Channel, httpPkg)
 		if err != nil {
@@ -78,5 +126,8 @@ func (m *StatusModel) Fetch(ctx context.Context, client api.ClientWithResponsesI
 	}
 
 	m.Update(s.JSON200.LastRound, s.JSON200.CatchupTime, s.JSON200.Catchpoint, s.JSON200.UpgradeNodeVote)
+	m.Catchpoint.Update(s.JSON200, time.Now())
+	m.Upgrade.Update(s.JSON200)
+	m.UpdateHealth(s.JSON200, 0, DefaultStallFactor)
 	return nil
 }
@@ -36,7 +36,7 @@ func Test_StatusModel(t *testing.T) {
 func Test_StatusFetch(t *testing.T) {
 	client := test.GetClient(true)
 	m := StatusModel{LastRound: 0}
-	pkg := new(HttpPkg)
+	pkg := new(testResponse)
 	err := m.Fetch(context.Background(), client, pkg)
 	if err == nil {
 		t.Error("expected error, got nil")
@@ -0,0 +1,345 @@
+package internal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordKind is the type of a Record in the Store
+type RecordKind string
+
+const (
+	RoundRecord         RecordKind = "round"
+	NodeDownRecord      RecordKind = "node-down"
+	NodeRecoveredRecord RecordKind = "node-recovered"
+	AccountStatusRecord RecordKind = "account-status"
+	KeyAddedRecord      RecordKind = "key-added"
+	KeyRemovedRecord    RecordKind = "key-removed"
+	BandwidthRecord     RecordKind = "bandwidth"
+	ProposalRecord      RecordKind = "proposal"
+	AbsenceRiskRecord   RecordKind = "absence-risk"
+	NodeStalledRecord   RecordKind = "node-stalled"
+	NodeResumedRecord   RecordKind = "node-resumed"
+	ClockSkewRecord     RecordKind = "clock-skew"
+)
+
+// storeDateFormat names the file holding the records of a day
+const storeDateFormat = "2006-01-02"
+
+// Record is a line in the Store, only the fields of its Kind are set
+type Record struct {
+	Time     time.Time  `json:"time"`
+	Kind     RecordKind `json:"kind"`
+	Round    uint64     `json:"round,omitempty"`
+	Address  string     `json:"address,omitempty"`
+	Key      string     `json:"key,omitempty"`
+	Previous string     `json:"previous,omitempty"`
+	Status   string     `json:"status,omitempty"`
+	Error    string     `json:"error,omitempty"`
+	RX       int        `json:"rx,omitempty"`
+	TX       int        `json:"tx,omitempty"`
+	// Payout is the proposer payout in microAlgos
+	Payout int `json:"payout,omitempty"`
+	// Reason explains an AbsenceRiskRecord, NodeStalledRecord or ClockSkewRecord
+	Reason string `json:"reason,omitempty"`
+}
+
+// Filter narrows a Query, empty fields match every Record
+type Filter struct {
+	Kinds   []RecordKind
+	Address string
+}
+
+func (f Filter) matches(record Record) bool {
+	if len(f.Kinds) > 0 && !slices.Contains(f.Kinds, record.Kind) {
+		return false
+	}
+	return f.Address == "" || f.Address == record.Address
+}
+
+// Store keeps Records on disk as one JSON lines file per UTC day
+type Store struct {
+	Dir string
+	mu  sync.Mutex
+}
+
+// OpenStore creates the directory of the Store when it is missing
+func OpenStore(dir string) (*Store, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{Dir: dir}, nil
+}
+
+func (s *Store) path(day time.Time) string {
+	return filepath.Join(s.Dir, day.UTC().Format(storeDateFormat)+".jsonl")
+}
+
+// Append writes the records to the file of their day
+func (s *Store) Append(records ...Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := make(map[string]*os.File)
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+	for _, record := range records {
+		path := s.path(record.Time)
+		f, ok := files[path]
+		if !ok {
+			var err error
+			f, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+			if err != nil {
+				return err
+			}
+			files[path] = f
+		}
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(append(line, '\n'))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// days lists the dates of the files in the Store, oldest first
+func (s *Store) days() ([]time.Time, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var days []time.Time
+	for _, entry := range entries {
+		day, err := time.Parse(storeDateFormat, strings.TrimSuffix(entry.Name(), ".jsonl"))
+		if err != nil || entry.IsDir() || !strings.HasSuffix(entry.Name(), ".jsonl") {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+	return days, nil
+}
+
+// Query returns the records between from and to, inclusive, ordered by time
+func (s *Store) Query(from time.Time, to time.Time, filter Filter) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	days, err := s.days()
+	if err != nil {
+		return nil, err
+	}
+	firstDay := from.UTC().Truncate(24 * time.Hour)
+	var records []Record
+	for _, day := range days {
+		if day.Before(firstDay) || day.After(to) {
+			continue
+		}
+		dayRecords, err := s.read(day)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range dayRecords {
+			if record.Time.Before(from) || record.Time.After(to) || !filter.matches(record) {
+				continue
+			}
+			records = append(records, record)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+	return records, nil
+}
+
+// read parses a day file, skipping a partially written last line
+func (s *Store) read(day time.Time) ([]Record, error) {
+	f, err := os.Open(s.path(day))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record Record
+		if json.Unmarshal(scanner.Bytes(), &record) != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// Prune deletes the days before the given time
+func (s *Store) Prune(before time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	days, err := s.days()
+	if err != nil {
+		return err
+	}
+	lastDay := before.UTC().Truncate(24 * time.Hour)
+	var errs []error
+	for _, day := range days {
+		if day.Before(lastDay) {
+			errs = append(errs, os.Remove(s.path(day)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Outage is a period where the node could not be reached
+type Outage struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Ongoing is true when the node had not recovered by the end of the records
+	Ongoing bool `json:"ongoing"`
+}
+
+// Outages pairs the NodeDownRecord and NodeRecoveredRecord of ordered records,
+// an outage without recovery ends at end. A RoundRecord also ends an outage since
+// algorun may have been restarted after the node recovered.
+func Outages(records []Record, end time.Time) []Outage {
+	var outages []Outage
+	var current *Outage
+	for _, record := range records {
+		switch record.Kind {
+		case NodeDownRecord:
+			if current == nil {
+				current = &Outage{Start: record.Time}
+			}
+		case NodeRecoveredRecord, RoundRecord:
+			if current != nil {
+				current.End = record.Time
+				outages = append(outages, *current)
+				current = nil
+			}
+		}
+	}
+	if current != nil {
+		current.End = end
+		current.Ongoing = true
+		outages = append(outages, *current)
+	}
+	return outages
+}
+
+// Recorder turns the Updates of a Watcher into Records of a Store
+type Recorder struct {
+	Store *Store
+	// RoundInterval is the minimum time between two RoundRecord
+	RoundInterval time.Duration
+
+	lastRound   time.Time
+	lastMetrics time.Time
+}
+
+// NewRecorder records a round every minute
+func NewRecorder(store *Store) *Recorder {
+	return &Recorder{Store: store, RoundInterval: time.Minute}
+}
+
+// Records lists what should be stored for an Update
+func (r *Recorder) Records(update Update, now time.Time) []Record {
+	var records []Record
+	round := update.State.Status.LastRound
+	for _, event := range update.Events {
+		switch event := event.(type) {
+		case RoundAdvanced:
+			if now.Sub(r.lastRound) >= r.RoundInterval {
+				r.lastRound = now
+				records = append(records, Record{Time: now, Kind: RoundRecord, Round: event.Round})
+			}
+		case NodeDown:
+			record := Record{Time: now, Kind: NodeDownRecord, Round: round}
+			if event.Err != nil {
+				record.Error = event.Err.Error()
+			}
+			records = append(records, record)
+		case NodeRecovered:
+			records = append(records, Record{Time: now, Kind: NodeRecoveredRecord, Round: event.Round})
+		case AccountStatusChanged:
+			records = append(records, Record{
+				Time:     now,
+				Kind:     AccountStatusRecord,
+				Round:    round,
+				Address:  event.Address,
+				Previous: event.Previous,
+				Status:   event.Status,
+			})
+		case KeyAdded:
+			records = append(records, Record{Time: now, Kind: KeyAddedRecord, Round: round, Address: event.Key.Address, Key: event.Key.Id})
+		case KeyRemoved:
+			records = append(records, Record{Time: now, Kind: KeyRemovedRecord, Round: round, Address: event.Key.Address, Key: event.Key.Id})
+		case BlockProposed:
+			records = append(records, Record{Time: now, Kind: ProposalRecord, Round: event.Round, Address: event.Address, Payout: event.Payout})
+		case AbsenceRiskChanged:
+			records = append(records, Record{
+				Time:     now,
+				Kind:     AbsenceRiskRecord,
+				Round:    round,
+				Address:  event.Address,
+				Previous: string(event.Previous),
+				Status:   string(event.Risk),
+				Reason:   event.Reason,
+			})
+		case NodeStalled:
+			records = append(records, Record{
+				Time:   now,
+				Kind:   NodeStalledRecord,
+				Round:  event.Round,
+				Reason: fmt.Sprintf("no round for %s", event.Since.Round(time.Second)),
+			})
+		case NodeResumed:
+			records = append(records, Record{Time: now, Kind: NodeResumedRecord, Round: event.Round})
+		case ClockSkewChanged:
+			status := "synced"
+			if event.Skewed {
+				status = "skewed"
+			}
+			records = append(records, Record{
+				Time:   now,
+				Kind:   ClockSkewRecord,
+				Round:  round,
+				Status: status,
+				Reason: fmt.Sprintf("local clock is %s from the last block", FormatSkew(event.Skew)),
+			})
+		}
+	}
+	// Bandwidth is sampled when the metrics are fetched
+	metrics := update.State.Metrics
+	if metrics.Enabled && metrics.LastTS.After(r.lastMetrics) {
+		if !r.lastMetrics.IsZero() {
+			records = append(records, Record{Time: now, Kind: BandwidthRecord, Round: round, RX: metrics.RX, TX: metrics.TX})
+		}
+		r.lastMetrics = metrics.LastTS
+	}
+	return records
+}
+
+// Write stores the records of an Update
+func (r *Recorder) Write(update Update) error {
+	records := r.Records(update, time.Now())
+	if len(records) == 0 {
+		return nil
+	}
+	return r.Store.Append(records...)
+}
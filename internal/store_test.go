@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_Store(t *testing.T) {
+	store, err := OpenStore(filepath.Join(t.TempDir(), "history"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2024, 11, 1, 23, 59, 0, 0, time.UTC)
+	err = store.Append(
+		Record{Time: day, Kind: RoundRecord, Round: 100},
+		Record{Time: day.Add(time.Minute), Kind: NodeDownRecord, Round: 100, Error: "connection refused"},
+		Record{Time: day.Add(time.Hour), Kind: NodeRecoveredRecord, Round: 120},
+		Record{Time: day.Add(time.Hour * 25), Kind: AccountStatusRecord, Address: "ABC", Previous: "Online", Status: "Offline"},
+		Record{Time: day.Add(time.Hour * 26), Kind: AccountStatusRecord, Address: "DEF", Previous: "Offline", Status: "Online"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A line cut by a crash is skipped
+	f, err := os.OpenFile(filepath.Join(store.Dir, "2024-11-02.jsonl"), os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"time":"2024-11-02T`)
+	_ = f.Close()
+
+	records, err := store.Query(day, day.Add(time.Hour*48), Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 5 || records[0].Kind != RoundRecord || records[4].Address != "DEF" {
+		t.Errorf("expected every record in order, got %+v", records)
+	}
+
+	records, err = store.Query(day.Add(time.Second), day.Add(time.Hour), Filter{Kinds: []RecordKind{NodeDownRecord, NodeRecoveredRecord}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Error != "connection refused" {
+		t.Errorf("expected the outage, got %+v", records)
+	}
+
+	records, err = store.Query(day, day.Add(time.Hour*48), Filter{Address: "ABC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Status != "Offline" {
+		t.Errorf("expected the account going offline, got %+v", records)
+	}
+
+	err = store.Prune(day.Add(time.Hour * 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err = store.Query(day.Add(-time.Hour*24), day.Add(time.Hour*48), Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 || records[0].Kind != NodeDownRecord {
+		t.Errorf("expected the first day to be pruned, got %+v", records)
+	}
+}
+
+func Test_Outages(t *testing.T) {
+	start := time.Unix(1000, 0)
+	records := []Record{
+		{Time: start, Kind: NodeDownRecord},
+		{Time: start.Add(time.Minute), Kind: NodeDownRecord},
+		{Time: start.Add(time.Hour), Kind: NodeRecoveredRecord},
+		{Time: start.Add(time.Hour * 2), Kind: NodeRecoveredRecord},
+		{Time: start.Add(time.Hour * 3), Kind: NodeDownRecord},
+	}
+	end := start.Add(time.Hour * 4)
+	outages := Outages(records, end)
+	if len(outages) != 2 {
+		t.Fatalf("expected 2 outages, got %+v", outages)
+	}
+	if outages[0].End.Sub(outages[0].Start) != time.Hour || outages[0].Ongoing {
+		t.Errorf("unexpected first outage %+v", outages[0])
+	}
+	if !outages[1].Ongoing || outages[1].End != end {
+		t.Errorf("expected the last outage to be ongoing, got %+v", outages[1])
+	}
+}
+
+func Test_RecorderRecords(t *testing.T) {
+	recorder := NewRecorder(nil)
+	now := time.Unix(1000, 0)
+	key := api.ParticipationKey{Id: "123", Address: "ABC"}
+	update := Update{
+		State: StateModel{Status: StatusModel{LastRound: 10}},
+		Events: []Event{
+			RoundAdvanced{Previous: 9, Round: 10},
+			KeyAdded{Key: key},
+			AccountStatusChanged{Address: "ABC", Previous: "Offline", Status: "Online"},
+			BlockProposed{Address: "ABC", Round: 8},
+			AbsenceRiskChanged{Address: "ABC", Previous: HealthyRisk, Risk: AtRisk, Reason: "no proposal"},
+		},
+	}
+	records := recorder.Records(update, now)
+	if len(records) != 5 || records[0].Round != 10 || records[1].Key != "123" || records[2].Status != "Online" || records[3].Round != 8 ||
+		records[4].Kind != AbsenceRiskRecord || records[4].Status != "at-risk" || records[4].Reason != "no proposal" {
+		t.Errorf("unexpected records %+v", records)
+	}
+
+	update.Events = []Event{
+		NodeStalled{Round: 10, Since: time.Second * 20},
+		NodeResumed{Round: 11},
+		ClockSkewChanged{Skew: -time.Minute, Skewed: true},
+	}
+	records = recorder.Records(update, now)
+	if len(records) != 3 || records[0].Kind != NodeStalledRecord || records[0].Reason != "no round for 20s" ||
+		records[1].Kind != NodeResumedRecord || records[1].Round != 11 ||
+		records[2].Kind != ClockSkewRecord || records[2].Status != "skewed" || records[2].Reason != "local clock is -1m0s from the last block" {
+		t.Errorf("unexpected health records %+v", records)
+	}
+
+	// Rounds are sampled
+	update.Events = []Event{RoundAdvanced{Previous: 10, Round: 11}, NodeDown{Err: errors.New("down")}}
+	records = recorder.Records(update, now.Add(time.Second))
+	if len(records) != 1 || records[0].Kind != NodeDownRecord || records[0].Error != "down" {
+		t.Errorf("expected only the outage, got %+v", records)
+	}
+
+	// The first bandwidth sample is skipped since it has no previous counters
+	update.Events = nil
+	update.State.Metrics = MetricsModel{Enabled: true, LastTS: now, RX: 100, TX: 200}
+	if records = recorder.Records(update, now); len(records) != 0 {
+		t.Errorf("expected no bandwidth record, got %+v", records)
+	}
+	update.State.Metrics.LastTS = now.Add(time.Second * 15)
+	records = recorder.Records(update, now.Add(time.Second*15))
+	if len(records) != 1 || records[0].Kind != BandwidthRecord || records[0].TX != 200 {
+		t.Errorf("expected a bandwidth record, got %+v", records)
+	}
+	// Until the metrics are fetched again
+	if records = recorder.Records(update, now.Add(time.Second*16)); len(records) != 0 {
+		t.Errorf("expected no records, got %+v", records)
+	}
+}
+
+func Test_WatcherRecorder(t *testing.T) {
+	algod := fake.New(
+		fake.WithRound(100),
+		fake.WithRoundTime(time.Millisecond*20),
+		fake.WithScenarios(fake.NodeDownAt(103)),
+	)
+	defer algod.Close()
+	client := algod.Client()
+
+	store, err := OpenStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	watcher := NewWatcher(&StateModel{Status: StatusModel{Version: "v3.0.0-stable"}, Client: client}, client)
+	watcher.Backoff = Backoff{Min: time.Millisecond * 10, Max: time.Millisecond * 50}
+	watcher.Recorder = NewRecorder(store)
+	updates, _ := watcher.Subscribe(16)
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	recovered := false
+	for update := range updates {
+		for _, event := range update.Events {
+			switch event.(type) {
+			case NodeDown:
+				algod.SetDown(false)
+			case NodeRecovered:
+				recovered = true
+			}
+		}
+		if recovered {
+			break
+		}
+	}
+	cancel()
+
+	records, err := store.Query(time.Now().Add(-time.Minute), time.Now(), Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outages := Outages(records, time.Now())
+	if len(outages) != 1 || outages[0].Ongoing {
+		t.Errorf("expected a recovered outage, got %+v", records)
+	}
+	if len(records) == 0 || records[0].Kind != RoundRecord {
+		t.Errorf("expected the round progress, got %+v", records)
+	}
+}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// UnlikelyDrySpell is the probability under which a dry spell is reported as a problem
+const UnlikelyDrySpell = 0.01
+
+// Supply is the stake of the ledger in microAlgos
+type Supply struct {
+	Round       uint64
+	OnlineMoney int
+	TotalMoney  int
+}
+
+// GetSupply fetches the online and total stake of the ledger
+func GetSupply(ctx context.Context, client api.ClientWithResponsesInterface) (*Supply, error) {
+	res, err := client.GetSupplyWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode() != 200 {
+		return nil, errors.New(res.Status())
+	}
+	return &Supply{
+		Round:       uint64(res.JSON200.CurrentRound),
+		OnlineMoney: res.JSON200.OnlineMoney,
+		TotalMoney:  res.JSON200.TotalMoney,
+	}, nil
+}
+
+// ProposalEstimate compares the proposals of an account with its share of the online stake
+type ProposalEstimate struct {
+	// Share is the fraction of the online stake held by the account
+	Share float64
+	// BlocksPerDay is the expected number of proposals in a day
+	BlocksPerDay float64
+	// DrySpell is the number of rounds without a proposal since the last one, or since the
+	// first valid round of the registered key when the account never proposed with it
+	DrySpell uint64
+	// Probability is the chance of a DrySpell this long with the Share
+	Probability float64
+}
+
+// Unlikely is true when the dry spell is too long to be bad luck
+func (e ProposalEstimate) Unlikely() bool {
+	return e.DrySpell > 0 && e.Probability < UnlikelyDrySpell
+}
+
+// EstimateProposals uses the online stake to know how often an online account should propose,
+// it is nil without the supply or the round time
+func EstimateProposals(account Account, supply *Supply, lastRound uint64, roundTime time.Duration) *ProposalEstimate {
+	if account.Status != "Online" || supply == nil || supply.OnlineMoney <= 0 || roundTime <= 0 {
+		return nil
+	}
+	// Each round is proposed by an account picked in proportion to its stake in microAlgos
+	share := min(1, float64(account.Amount)/float64(supply.OnlineMoney))
+	estimate := ProposalEstimate{
+		Share:        share,
+		BlocksPerDay: share * float64(time.Hour*24) / float64(roundTime),
+		Probability:  1,
+	}
+	since := uint64(account.LastProposed)
+	if account.Participation != nil {
+		since = max(since, uint64(account.Participation.VoteFirstValid))
+	}
+	if since > 0 && lastRound > since {
+		estimate.DrySpell = lastRound - since
+		estimate.Probability = math.Exp(float64(estimate.DrySpell) * math.Log1p(-share))
+	}
+	return &estimate
+}
+
+// UpdateSupply refreshes the online stake, the previous supply is kept when the request fails
+func (s *StateModel) UpdateSupply(ctx context.Context, client api.ClientWithResponsesInterface) {
+	supply, err := GetSupply(ctx, client)
+	if err == nil {
+		s.Supply = supply
+	}
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_GetSupply(t *testing.T) {
+	address := fake.Address("supply")
+	algod := fake.New(fake.WithAccounts(api.Account{Address: address, Amount: 5_000_000, Status: "Online"}))
+	defer algod.Close()
+
+	supply, err := GetSupply(context.Background(), algod.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if supply.OnlineMoney != 5_000_000 || supply.TotalMoney <= supply.OnlineMoney {
+		t.Errorf("unexpected supply %+v", supply)
+	}
+
+	if _, err = GetSupply(context.Background(), test.NewClient(false, true)); err == nil {
+		t.Error("expected an error for an unauthorized request")
+	}
+
+	// The previous supply is kept on errors
+	state := StateModel{}
+	state.UpdateSupply(context.Background(), test.GetClient(false))
+	state.UpdateSupply(context.Background(), test.GetClient(true))
+	if state.Supply == nil || state.Supply.OnlineMoney != 2_000_000_000_000_000 {
+		t.Errorf("expected the supply of the first request, got %+v", state.Supply)
+	}
+}
+
+func Test_EstimateProposals(t *testing.T) {
+	// 1% of a stake of 1B ALGO with 2.5s rounds
+	supply := &Supply{OnlineMoney: 1_000_000_000_000_000}
+	account := Account{Status: "Online", Balance: 10_000_000, Amount: 10_000_000_000_000, LastProposed: 1000}
+	estimate := EstimateProposals(account, supply, 1100, time.Millisecond*2500)
+	if estimate == nil {
+		t.Fatal("expected an estimate")
+	}
+	if estimate.Share != 0.01 || math.Abs(estimate.BlocksPerDay-345.6) > 1e-9 {
+		t.Errorf("unexpected rate %+v", estimate)
+	}
+	if estimate.DrySpell != 100 || math.Abs(estimate.Probability-math.Pow(0.99, 100)) > 1e-9 || estimate.Unlikely() {
+		t.Errorf("expected a likely dry spell of 100 rounds, got %+v", estimate)
+	}
+
+	// The share uses the microAlgos, not the whole ALGO
+	small := EstimateProposals(Account{Status: "Online", Balance: 1, Amount: 1_900_000}, supply, 1100, time.Second)
+	if small.Share != 1.9e-9 {
+		t.Errorf("expected the share of 1.9 ALGO, got %v", small.Share)
+	}
+
+	// 1000 rounds without a proposal is bad luck less than once in 20000
+	estimate = EstimateProposals(account, supply, 2000, time.Millisecond*2500)
+	if !estimate.Unlikely() {
+		t.Errorf("expected an unlikely dry spell, got %+v", estimate)
+	}
+
+	// Accounts that never proposed have no dry spell without a registered key
+	account.LastProposed = 0
+	if estimate = EstimateProposals(account, supply, 2000, time.Second); estimate.DrySpell != 0 || estimate.Unlikely() {
+		t.Errorf("expected no dry spell, got %+v", estimate)
+	}
+	// They are measured from the first round of their key instead
+	account.Participation = &api.AccountParticipation{VoteFirstValid: 500}
+	if estimate = EstimateProposals(account, supply, 2000, time.Second); estimate.DrySpell != 1500 || !estimate.Unlikely() {
+		t.Errorf("expected an unlikely dry spell of 1500 rounds since the registration, got %+v", estimate)
+	}
+	// A proposal after the first round of the key ends the dry spell
+	account.LastProposed = 1950
+	if estimate = EstimateProposals(account, supply, 2000, time.Second); estimate.DrySpell != 50 || estimate.Unlikely() {
+		t.Errorf("expected a dry spell of 50 rounds since the proposal, got %+v", estimate)
+	}
+
+	for name, args := range map[string]struct {
+		account   Account
+		supply    *Supply
+		roundTime time.Duration
+	}{
+		"Offline":   {Account{Status: "Offline", Amount: 10}, supply, time.Second},
+		"NoSupply":  {Account{Status: "Online", Amount: 10}, nil, time.Second},
+		"NoStake":   {Account{Status: "Online", Amount: 10}, &Supply{}, time.Second},
+		"RoundTime": {Account{Status: "Online", Amount: 10}, supply, 0},
+	} {
+		if EstimateProposals(args.account, args.supply, 2000, args.roundTime) != nil {
+			t.Errorf("expected no estimate for %s", name)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// CatchupBehind is the number of rounds behind the network above which a fast catchup is suggested
+const CatchupBehind = 100000
+
+// SyncSampleInterval is the minimum time between two samples of the sync rate
+const SyncSampleInterval = time.Second * 5
+
+// syncSamples is the number of samples the sync rate is measured over, about a minute
+const syncSamples = 12
+
+type syncSample struct {
+	Round uint64
+	Time  time.Time
+}
+
+// SyncModel is the progress of a syncing node, compared with a reference node of the same network when there is one.
+// Samples are kept in an array so snapshots don't share them
+type SyncModel struct {
+	// NetworkRound is the last round of the reference node, 0 without a reference
+	NetworkRound uint64
+	// Catchpoint is the last catchpoint of the reference node, empty when it doesn't track catchpoints
+	Catchpoint string
+	// CheckedAt is when the reference node was last read
+	CheckedAt time.Time
+
+	samples [syncSamples]syncSample
+	count   int
+}
+
+// Sample records the round of the node, at most every SyncSampleInterval
+func (s *SyncModel) Sample(round uint64, now time.Time) {
+	if s.count > 0 {
+		last := s.samples[(s.count-1)%syncSamples]
+		// The node restarted from an earlier round
+		if round < last.Round {
+			s.samples = [syncSamples]syncSample{}
+			s.count = 0
+		} else if now.Sub(last.Time) < SyncSampleInterval {
+			return
+		}
+	}
+	s.samples[s.count%syncSamples] = syncSample{Round: round, Time: now}
+	s.count++
+}
+
+// Reset forgets the samples and the reference once the node is synced
+func (s *SyncModel) Reset() {
+	*s = SyncModel{}
+}
+
+// Rate is the number of rounds synced per second over the samples, 0 until there are two
+func (s SyncModel) Rate() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	newest := s.samples[(s.count-1)%syncSamples]
+	oldest := s.samples[max(0, s.count-syncSamples)%syncSamples]
+	elapsed := newest.Time.Sub(oldest.Time).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(newest.Round-oldest.Round) / elapsed
+}
+
+// Behind is the number of rounds between the node and the reference node
+func (s SyncModel) Behind(lastRound uint64) uint64 {
+	if s.NetworkRound <= lastRound {
+		return 0
+	}
+	return s.NetworkRound - lastRound
+}
+
+// ETA estimates the time to catch up with the network, which keeps producing a round every roundTime,
+// or FallbackRoundTime when it is unknown. It is false without a reference or while the node falls behind
+func (s SyncModel) ETA(lastRound uint64, roundTime time.Duration) (time.Duration, bool) {
+	behind := s.Behind(lastRound)
+	if s.NetworkRound == 0 || behind == 0 {
+		return 0, false
+	}
+	if roundTime <= 0 {
+		roundTime = FallbackRoundTime
+	}
+	closing := s.Rate() - 1/roundTime.Seconds()
+	if closing <= 0 {
+		return 0, false
+	}
+	return time.Duration(float64(behind) / closing * float64(time.Second)).Round(time.Second), true
+}
+
+// SuggestCatchup is true when the node is more than CatchupBehind rounds behind the network
+func (s SyncModel) SuggestCatchup(lastRound uint64) bool {
+	return s.Behind(lastRound) > CatchupBehind
+}
+
+// UpdateNetwork reads the last round and catchpoint of the reference node
+func (s *SyncModel) UpdateNetwork(ctx context.Context, reference api.ClientWithResponsesInterface, now time.Time) error {
+	s.CheckedAt = now
+	res, err := reference.GetStatusWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != 200 {
+		return fmt.Errorf("Status code %d: %s", res.StatusCode(), res.Status())
+	}
+	s.NetworkRound = uint64(res.JSON200.LastRound)
+	s.Catchpoint = ""
+	if res.JSON200.LastCatchpoint != nil {
+		s.Catchpoint = *res.JSON200.LastCatchpoint
+	}
+	return nil
+}
+
+// CheckReference verifies the reference node follows the network of the base64 genesis hash
+func CheckReference(ctx context.Context, reference api.ClientWithResponsesInterface, genesisHash string) error {
+	v, err := reference.GetVersionWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if v.StatusCode() != 200 {
+		return fmt.Errorf("Status code %d: %s", v.StatusCode(), v.Status())
+	}
+	if hash := base64.StdEncoding.EncodeToString(v.JSON200.GenesisHashB64); hash != genesisHash {
+		return fmt.Errorf("the reference node is on %s, not on the network of the node", v.JSON200.GenesisId)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_SyncModel(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	sync := SyncModel{NetworkRound: 1_000_000}
+	if sync.Rate() != 0 {
+		t.Errorf("expected no rate without samples, got %f", sync.Rate())
+	}
+	if _, ok := sync.ETA(400_000, time.Second*3); ok {
+		t.Error("expected no ETA without a rate")
+	}
+
+	// Samples closer than the SyncSampleInterval are skipped
+	sync.Sample(400_000, start)
+	sync.Sample(400_100, start.Add(time.Second))
+	if sync.Rate() != 0 {
+		t.Errorf("expected a single sample, got %f", sync.Rate())
+	}
+	for i := 1; i <= 20; i++ {
+		sync.Sample(uint64(400_000+i*1000), start.Add(time.Duration(i)*SyncSampleInterval))
+	}
+	// 1000 rounds every 5s over the last samples
+	if sync.Rate() != 200 {
+		t.Errorf("expected 200 rounds/s, got %f", sync.Rate())
+	}
+	if sync.Behind(420_000) != 580_000 || !sync.SuggestCatchup(420_000) || sync.SuggestCatchup(950_000) {
+		t.Errorf("expected 580000 rounds behind, got %d", sync.Behind(420_000))
+	}
+	// The network produces a third of a round per second, 580000 / (200 - 1/3) seconds
+	eta, ok := sync.ETA(420_000, time.Second*3)
+	if !ok || eta != time.Second*2905 {
+		t.Errorf("expected an ETA, got %s", eta)
+	}
+	if sync.Behind(1_000_100) != 0 {
+		t.Error("expected a node ahead of the reference not to be behind")
+	}
+
+	// A restarted node starts over
+	sync.Sample(10, start.Add(time.Hour))
+	if sync.Rate() != 0 {
+		t.Errorf("expected the samples to be reset, got %f", sync.Rate())
+	}
+	sync.Reset()
+	if sync.NetworkRound != 0 {
+		t.Error("expected the reference to be reset")
+	}
+}
+
+func Test_CheckReference(t *testing.T) {
+	ctx := context.Background()
+	algod := fake.New()
+	defer algod.Close()
+	reference := fake.New()
+	defer reference.Close()
+	other := fake.New()
+	other.GenesisId = "othernet-v1"
+	defer other.Close()
+
+	status := StatusModel{}
+	err := status.Fetch(ctx, algod.Client(), new(testResponse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = CheckReference(ctx, reference.Client(), status.GenesisHash); err != nil {
+		t.Errorf("expected the reference to be on the same network, got %s", err)
+	}
+	if err = CheckReference(ctx, other.Client(), status.GenesisHash); err == nil {
+		t.Error("expected a reference on another network to be refused")
+	}
+}
+
+func Test_WatcherSync(t *testing.T) {
+	algod := fake.New(fake.WithRound(200), fake.WithRoundTime(time.Millisecond*20))
+	defer algod.Close()
+	algod.SetSyncing(true)
+	reference := fake.New(fake.WithRound(300_000))
+	reference.LastCatchpoint = "290000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA"
+	defer reference.Close()
+	client := algod.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	watcher := NewWatcher(&StateModel{
+		Status: StatusModel{Version: "v3.0.0-stable", State: StableState},
+		Client: client,
+	}, client)
+	watcher.Reference = reference.Client()
+	updates, unsubscribe := watcher.Subscribe(16)
+	defer unsubscribe()
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	// Wait for an update, acting on the node when needed
+	wait := func(match func(StatusModel) bool) {
+		t.Helper()
+		for {
+			select {
+			case update := <-updates:
+				if match(update.State.Status) {
+					return
+				}
+			case <-ctx.Done():
+				t.Fatal("timed out waiting for the sync progress")
+			}
+		}
+	}
+	wait(func(status StatusModel) bool {
+		return status.State == SyncingState && status.Sync.NetworkRound == 300_000 &&
+			status.Sync.Catchpoint == reference.LastCatchpoint && status.Sync.SuggestCatchup(status.LastRound)
+	})
+	// The reference is read every ReferenceInterval
+	requests := reference.Requests("GET /v2/status")
+	time.Sleep(time.Millisecond * 200)
+	if reference.Requests("GET /v2/status") != requests {
+		t.Error("expected the reference to be read once")
+	}
+
+	algod.SetSyncing(false)
+	wait(func(status StatusModel) bool {
+		return status.State == StableState && status.Sync.NetworkRound == 0
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+	"io"
 	"net/http"
 )
 
@@ -235,6 +236,22 @@ func (c *Client) GetVersionWithResponse(ctx context.Context, reqEditors ...api.R
 	}
 	return &res, nil
 }
+
+// GetGenesisWithResponse answers with the genesis of a dev mode network
+func (c *Client) GetGenesisWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetGenesisResponse, error) {
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	if c.Invalid {
+		return &api.GetGenesisResponse{HTTPResponse: &http.Response{StatusCode: 404}}, nil
+	}
+	devMode := true
+	return &api.GetGenesisResponse{
+		HTTPResponse: &http.Response{StatusCode: 200},
+		JSON200:      &api.Genesis{Network: "tui-net", Id: "v1", Proto: "future", Devmode: &devMode},
+	}, nil
+}
+
 func (c *Client) GetStatusWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetStatusResponse, error) {
 	httpResponse := http.Response{StatusCode: 200}
 	data := new(struct {
@@ -276,3 +293,167 @@ func (c *Client) GetStatusWithResponse(ctx context.Context, reqEditors ...api.Re
 
 	return &res, nil
 }
+
+func (c *Client) StartCatchupWithResponse(ctx context.Context, catchpoint string, params *api.StartCatchupParams, reqEditors ...api.RequestEditorFn) (*api.StartCatchupResponse, error) {
+	var res api.StartCatchupResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.StartCatchupResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				CatchupMessage string `json:"catchup-message"`
+			}{CatchupMessage: catchpoint},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 400, Status: "400 Bad Request"}
+		res = api.StartCatchupResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON400:      &api.ErrorResponse{Message: "catchup already in progress"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+func (c *Client) GetSupplyWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetSupplyResponse, error) {
+	var res api.GetSupplyResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.GetSupplyResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				CurrentRound int `json:"current_round"`
+				OnlineMoney  int `json:"online-money"`
+				TotalMoney   int `json:"total-money"`
+			}{CurrentRound: 1337, OnlineMoney: 2_000_000_000_000_000, TotalMoney: 10_000_000_000_000_000},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 401, Status: "401 Unauthorized"}
+		res = api.GetSupplyResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON401:      &api.ErrorResponse{Message: "Invalid API Token"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+func (c *Client) AbortCatchupWithResponse(ctx context.Context, catchpoint string, reqEditors ...api.RequestEditorFn) (*api.AbortCatchupResponse, error) {
+	var res api.AbortCatchupResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.AbortCatchupResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				CatchupMessage string `json:"catchup-message"`
+			}{CatchupMessage: catchpoint},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 400, Status: "400 Bad Request"}
+		res = api.AbortCatchupResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+func (c *Client) TransactionParamsWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.TransactionParamsResponse, error) {
+	var res api.TransactionParamsResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.TransactionParamsResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				ConsensusVersion string `json:"consensus-version"`
+				Fee              int    `json:"fee"`
+				GenesisHash      []byte `json:"genesis-hash"`
+				GenesisId        string `json:"genesis-id"`
+				LastRound        int    `json:"last-round"`
+				MinFee           int    `json:"min-fee"`
+			}{
+				ConsensusVersion: "https://github.com/algorandfoundation/specs/tree/236dcc18c9c507d794813ab768e467ea42d1b4d9",
+				GenesisHash:      make([]byte, 32),
+				GenesisId:        "tuinet-v1.0",
+				LastRound:        1337,
+				MinFee:           1000,
+			},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 401, Status: "401 Unauthorized"}
+		res = api.TransactionParamsResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON401:      &api.ErrorResponse{Message: "Invalid API Token"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+func (c *Client) RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*api.RawTransactionResponse, error) {
+	var res api.RawTransactionResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		res = api.RawTransactionResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON200: &struct {
+				TxId string `json:"txId"`
+			}{TxId: "TXID"},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 400, Status: "400 Bad Request"}
+		res = api.RawTransactionResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON400:      &api.ErrorResponse{Message: "transaction rejected"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
+
+func (c *Client) PendingTransactionInformationWithResponse(ctx context.Context, txid string, params *api.PendingTransactionInformationParams, reqEditors ...api.RequestEditorFn) (*api.PendingTransactionInformationResponse, error) {
+	var res api.PendingTransactionInformationResponse
+	if !c.Invalid {
+		httpResponse := http.Response{StatusCode: 200}
+		confirmed := 10
+		res = api.PendingTransactionInformationResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON200: &api.PendingTransactionResponse{
+				ConfirmedRound: &confirmed,
+				Txn:            map[string]interface{}{},
+			},
+		}
+	} else {
+		httpResponse := http.Response{StatusCode: 404, Status: "404 Not Found"}
+		res = api.PendingTransactionInformationResponse{
+			Body:         nil,
+			HTTPResponse: &httpResponse,
+			JSON404:      &api.ErrorResponse{Message: "txn does not exist"},
+		}
+	}
+	if c.Errors {
+		return nil, errors.New("test error")
+	}
+	return &res, nil
+}
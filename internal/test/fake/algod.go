@@ -0,0 +1,965 @@
+// Package fake provides an in-process algod for end-to-end tests and demos
+package fake
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+)
+
+const (
+	// Token is the default admin token of the fake algod
+	Token = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	// RewardsPool is the special rewards pool address in the genesis file
+	RewardsPool = "7777777777777777777777777777777777777777777777777774MSJUVU"
+	// FeeSink is the special fee sink address in the genesis file
+	FeeSink = "A7NMWS3NT3IUDMLVO26ULGXGIIOUQ3ND2TXSER6EBGRZNOBOUIQXHIBGDE"
+	// GenesisTimestamp is the timestamp of round 0
+	GenesisTimestamp = 1700000000
+	// MinFee is the minimum transaction fee in microAlgos
+	MinFee = 1000
+	// IncentiveFee is the key registration fee making an account eligible for incentives
+	IncentiveFee = 2000000
+)
+
+// Algod is a scriptable algod REST API backed by an httptest.Server.
+// Exported fields may be changed before the first request, afterward use the methods
+type Algod struct {
+	*httptest.Server
+
+	// Token is the required X-Algo-API-Token, empty to allow every request
+	Token string
+	// Version is returned by /versions
+	Version api.BuildVersion
+	// GenesisId is the network name
+	GenesisId string
+	// DevMode is the devmode of the genesis
+	DevMode bool
+	// BlockTime is the time between block timestamps
+	BlockTime time.Duration
+	// RoundTime is how long wait-for-block blocks before producing a round
+	RoundTime time.Duration
+	// TxnsPerRound is added to the block transaction counter every round
+	TxnsPerRound int
+	// KeyGenDelay is how long participation key generation takes
+	KeyGenDelay time.Duration
+	// ProposerPayout is the payout in microAlgos of the blocks proposed by a scenario
+	ProposerPayout int
+	// LastCatchpoint is the last catchpoint reported by /v2/status, empty when catchpoints are not tracked
+	LastCatchpoint string
+
+	mu         sync.Mutex
+	round      int
+	down       bool
+	accounts   map[string]api.Account
+	keys       []api.ParticipationKey
+	proposers  map[int]string
+	catchup    *Catchup
+	upgrade    *Upgrade
+	sent       int
+	received   int
+	scenarios  []Scenario
+	requests   map[string]int
+	generating sync.WaitGroup
+	// transactions are the submitted transactions by id, confirmed by the next round
+	transactions map[string]*transaction
+	// stalled stops producing rounds
+	stalled bool
+	// syncing reports a catchup time without a catchpoint
+	syncing bool
+	// lastRoundAt is when the latest round was produced
+	lastRoundAt time.Time
+	// clock shifts the local time stamped on new blocks, nil for GenesisTimestamp plus BlockTime per round
+	clock      *time.Duration
+	timestamps map[int]int64
+	// failBlocks are the rounds whose next block request fails
+	failBlocks map[int]bool
+}
+
+// transaction is a submitted transaction, Round is 0 while it is pending
+type transaction struct {
+	Stx   types.SignedTxn
+	Round int
+}
+
+// Catchup is the fast catchup progress reported by /v2/status
+type Catchup struct {
+	Catchpoint        string
+	TotalAccounts     int
+	ProcessedAccounts int
+	VerifiedAccounts  int
+	TotalKvs          int
+	ProcessedKvs      int
+	VerifiedKvs       int
+	TotalBlocks       int
+	AcquiredBlocks    int
+}
+
+// Upgrade is a protocol upgrade reported by /v2/status, voted until VoteBefore and applied at ApplyRound
+type Upgrade struct {
+	NextVersion string
+	VoteBefore  int
+	YesVotes    int
+	NoVotes     int
+	ApplyRound  int
+	// Supported is false when the node can't run NextVersion
+	Supported bool
+	// Stopped reports the node stopped at the unsupported round
+	Stopped bool
+}
+
+// Option configures the Algod before it starts
+type Option func(*Algod)
+
+// WithRound sets the starting round
+func WithRound(round int) Option {
+	return func(a *Algod) {
+		a.round = round
+	}
+}
+
+// WithAccounts adds accounts to the ledger
+func WithAccounts(accounts ...api.Account) Option {
+	return func(a *Algod) {
+		for _, account := range accounts {
+			a.accounts[account.Address] = account
+		}
+	}
+}
+
+// WithKeys installs participation keys
+func WithKeys(keys ...api.ParticipationKey) Option {
+	return func(a *Algod) {
+		a.keys = append(a.keys, keys...)
+	}
+}
+
+// WithScenarios registers scenarios that run when their round is reached
+func WithScenarios(scenarios ...Scenario) Option {
+	return func(a *Algod) {
+		a.scenarios = append(a.scenarios, scenarios...)
+	}
+}
+
+// WithToken changes the required admin token
+func WithToken(token string) Option {
+	return func(a *Algod) {
+		a.Token = token
+	}
+}
+
+// WithRoundTime sets how long wait-for-block takes to produce a round
+func WithRoundTime(roundTime time.Duration) Option {
+	return func(a *Algod) {
+		a.RoundTime = roundTime
+	}
+}
+
+// New starts a fake algod, close it with Algod.Close
+func New(options ...Option) *Algod {
+	a := &Algod{
+		Token: Token,
+		Version: api.BuildVersion{
+			Branch:      "test",
+			BuildNumber: 0,
+			Channel:     "stable",
+			CommitHash:  "abc",
+			Major:       3,
+			Minor:       0,
+		},
+		GenesisId:      "fakenet-v1",
+		BlockTime:      time.Second * 3,
+		RoundTime:      time.Millisecond * 10,
+		TxnsPerRound:   30,
+		KeyGenDelay:    time.Millisecond * 100,
+		ProposerPayout: 10000000,
+		round:          1,
+		lastRoundAt:    time.Now(),
+		timestamps:     make(map[int]int64),
+		proposers:      make(map[int]string),
+		accounts: map[string]api.Account{
+			RewardsPool: {Address: RewardsPool, Amount: 125000000000000, Status: "Not Participating"},
+			FeeSink:     {Address: FeeSink, Amount: 100000, Status: "Not Participating"},
+		},
+		requests:     make(map[string]int),
+		transactions: make(map[string]*transaction),
+	}
+	for _, option := range options {
+		option(a)
+	}
+	a.Server = httptest.NewServer(a.routes())
+	return a
+}
+
+// Client creates an admin client for the fake algod
+func (a *Algod) Client() *api.ClientWithResponses {
+	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", a.Token)
+	if err != nil {
+		panic(err)
+	}
+	client, err := api.NewClientWithResponses(a.URL, api.WithRequestEditorFn(apiToken.Intercept))
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// Close waits for pending key generation and stops the server
+func (a *Algod) Close() {
+	a.generating.Wait()
+	a.Server.Close()
+}
+
+// Round is the latest round of the fake ledger
+func (a *Algod) Round() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.round
+}
+
+// Advance produces rounds and runs any scenario that is due
+func (a *Algod) Advance(rounds int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := 0; i < rounds; i++ {
+		a.advance()
+	}
+}
+
+// SetDown makes every request fail at the connection level
+func (a *Algod) SetDown(down bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.down = down
+}
+
+// SetAccount replaces an account in the ledger
+func (a *Algod) SetAccount(account api.Account) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.accounts[account.Address] = account
+}
+
+// Account returns an account from the ledger
+func (a *Algod) Account(address string) (api.Account, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	account, ok := a.accounts[address]
+	return account, ok
+}
+
+// Keys returns a copy of the installed participation keys
+func (a *Algod) Keys() []api.ParticipationKey {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]api.ParticipationKey{}, a.keys...)
+}
+
+// SetCatchup starts or clears (nil) a fast catchup
+func (a *Algod) SetCatchup(catchup *Catchup) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.catchup = catchup
+}
+
+// SetUpgrade starts or clears (nil) a protocol upgrade
+func (a *Algod) SetUpgrade(upgrade *Upgrade) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.upgrade = upgrade
+}
+
+// SetStalled stops producing rounds while the node keeps answering
+func (a *Algod) SetStalled(stalled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stalled = stalled
+}
+
+// FailBlock fails the next request of the block of a round with an internal error
+func (a *Algod) FailBlock(round int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.failBlocks == nil {
+		a.failBlocks = make(map[int]bool)
+	}
+	a.failBlocks[round] = true
+}
+
+// SetSyncing reports the node as catching up with the network, rounds are still produced by wait-for-block
+func (a *Algod) SetSyncing(syncing bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.syncing = syncing
+}
+
+// SetClock stamps the next rounds with the local clock shifted by skew,
+// instead of GenesisTimestamp plus BlockTime per round
+func (a *Algod) SetClock(skew time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.clock = &skew
+}
+
+// Requests counts the requests made to a route pattern, e.g. "GET /v2/status"
+func (a *Algod) Requests(pattern string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.requests[pattern]
+}
+
+// advance produces a single round, the lock must be held
+func (a *Algod) advance() {
+	a.round++
+	a.lastRoundAt = time.Now()
+	if a.clock != nil {
+		a.timestamps[a.round] = a.lastRoundAt.Add(*a.clock).Unix()
+	}
+	for _, pending := range a.transactions {
+		if pending.Round == 0 {
+			pending.Round = a.round
+			a.confirm(pending.Stx)
+		}
+	}
+	a.sent += 1024
+	a.received += 2048
+	remaining := a.scenarios[:0]
+	for _, scenario := range a.scenarios {
+		if a.round >= scenario.Round {
+			scenario.Apply(a)
+		} else {
+			remaining = append(remaining, scenario)
+		}
+	}
+	a.scenarios = remaining
+}
+
+// tickCatchup moves a fast catchup forward on every status request, the lock must be held
+func (a *Algod) tickCatchup() {
+	c := a.catchup
+	if c == nil {
+		return
+	}
+	switch {
+	case c.ProcessedAccounts < c.TotalAccounts || c.ProcessedKvs < c.TotalKvs:
+		c.ProcessedAccounts = min(c.TotalAccounts, c.ProcessedAccounts+max(1, c.TotalAccounts/4))
+		c.ProcessedKvs = min(c.TotalKvs, c.ProcessedKvs+max(1, c.TotalKvs/4))
+	case c.VerifiedAccounts < c.TotalAccounts || c.VerifiedKvs < c.TotalKvs:
+		c.VerifiedAccounts = min(c.TotalAccounts, c.VerifiedAccounts+max(1, c.TotalAccounts/4))
+		c.VerifiedKvs = min(c.TotalKvs, c.VerifiedKvs+max(1, c.TotalKvs/4))
+	case c.AcquiredBlocks < c.TotalBlocks:
+		c.AcquiredBlocks = min(c.TotalBlocks, c.AcquiredBlocks+max(1, c.TotalBlocks/4))
+	default:
+		// Jump to the catchpoint round once everything is done
+		var round int
+		_, _ = fmt.Sscanf(c.Catchpoint, "%d#", &round)
+		a.round = max(a.round, round)
+		a.catchup = nil
+	}
+}
+
+// routes maps every operation in generate.yaml
+func (a *Algod) routes() http.Handler {
+	mux := http.NewServeMux()
+	handle := func(pattern string, handler func(w http.ResponseWriter, r *http.Request)) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			a.mu.Lock()
+			a.requests[pattern]++
+			down := a.down
+			a.mu.Unlock()
+			if down {
+				hangUp(w)
+				return
+			}
+			if a.Token != "" && r.Header.Get("X-Algo-API-Token") != a.Token {
+				writeError(w, http.StatusUnauthorized, "Invalid API Token")
+				return
+			}
+			handler(w, r)
+		})
+	}
+	handle("GET /versions", a.getVersion)
+	handle("GET /genesis", a.getGenesis)
+	handle("GET /metrics", a.getMetrics)
+	handle("GET /v2/status", a.getStatus)
+	handle("GET /v2/status/wait-for-block-after/{round}", a.waitForBlock)
+	handle("GET /v2/blocks/{round}", a.getBlock)
+	handle("GET /v2/accounts/{address}", a.accountInformation)
+	handle("GET /v2/participation", a.getParticipationKeys)
+	handle("POST /v2/participation", a.addParticipationKey)
+	handle("POST /v2/participation/generate/{address}", a.generateParticipationKeys)
+	handle("GET /v2/participation/{id}", a.getParticipationKeyByID)
+	handle("POST /v2/participation/{id}", a.appendKeys)
+	handle("DELETE /v2/participation/{id}", a.deleteParticipationKeyByID)
+	handle("POST /v2/catchup/{catchpoint}", a.startCatchup)
+	handle("DELETE /v2/catchup/{catchpoint}", a.abortCatchup)
+	handle("GET /v2/ledger/supply", a.getSupply)
+	handle("GET /v2/transactions/params", a.transactionParams)
+	handle("POST /v2/transactions", a.rawTransaction)
+	handle("GET /v2/transactions/pending/{txid}", a.pendingTransactionInformation)
+	return mux
+}
+
+func (a *Algod) getVersion(w http.ResponseWriter, r *http.Request) {
+	hash := sha256.Sum256([]byte(a.GenesisId))
+	writeJSON(w, http.StatusOK, api.Version{
+		Build:          a.Version,
+		GenesisHashB64: hash[:],
+		GenesisId:      a.GenesisId,
+		Versions:       []string{"v2"},
+	})
+}
+
+func (a *Algod) getGenesis(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	alloc := make([]map[string]interface{}, 0, len(a.accounts))
+	for address, account := range a.accounts {
+		alloc = append(alloc, map[string]interface{}{
+			"addr":  address,
+			"state": map[string]interface{}{"algo": account.Amount},
+		})
+	}
+	network, id, _ := strings.Cut(a.GenesisId, "-")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"alloc":     alloc,
+		"devmode":   a.DevMode,
+		"fees":      FeeSink,
+		"id":        id,
+		"network":   network,
+		"proto":     "future",
+		"rwd":       RewardsPool,
+		"timestamp": GenesisTimestamp,
+	})
+}
+
+func (a *Algod) getMetrics(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = fmt.Fprintf(w, "# HELP algod_network_sent_bytes_total Total bytes sent\n")
+	_, _ = fmt.Fprintf(w, "# TYPE algod_network_sent_bytes_total counter\n")
+	_, _ = fmt.Fprintf(w, "algod_network_sent_bytes_total %d\n", a.sent)
+	_, _ = fmt.Fprintf(w, "# HELP algod_network_received_bytes_total Total bytes received\n")
+	_, _ = fmt.Fprintf(w, "# TYPE algod_network_received_bytes_total counter\n")
+	_, _ = fmt.Fprintf(w, "algod_network_received_bytes_total %d\n", a.received)
+	_, _ = fmt.Fprintf(w, "# HELP algod_ledger_round Latest round\n")
+	_, _ = fmt.Fprintf(w, "# TYPE algod_ledger_round gauge\n")
+	_, _ = fmt.Fprintf(w, "algod_ledger_round %d\n", a.round)
+}
+
+// status builds the /v2/status body, the lock must be held
+func (a *Algod) status() map[string]interface{} {
+	a.tickCatchup()
+	status := map[string]interface{}{
+		"catchup-time":                      0,
+		"last-round":                        a.round,
+		"last-version":                      "future",
+		"next-version":                      "future",
+		"next-version-round":                a.round + 1,
+		"next-version-supported":            true,
+		"stopped-at-unsupported-round":      false,
+		"time-since-last-round":             int(time.Since(a.lastRoundAt)),
+		"upgrade-node-vote":                 true,
+		"upgrade-next-protocol-vote-before": 0,
+	}
+	if a.LastCatchpoint != "" {
+		status["last-catchpoint"] = a.LastCatchpoint
+	}
+	if a.syncing {
+		status["catchup-time"] = 1
+	}
+	if c := a.catchup; c != nil {
+		status["catchup-time"] = 1
+		status["catchpoint"] = c.Catchpoint
+		status["catchpoint-total-accounts"] = c.TotalAccounts
+		status["catchpoint-processed-accounts"] = c.ProcessedAccounts
+		status["catchpoint-verified-accounts"] = c.VerifiedAccounts
+		status["catchpoint-total-kvs"] = c.TotalKvs
+		status["catchpoint-processed-kvs"] = c.ProcessedKvs
+		status["catchpoint-verified-kvs"] = c.VerifiedKvs
+		status["catchpoint-total-blocks"] = c.TotalBlocks
+		status["catchpoint-acquired-blocks"] = c.AcquiredBlocks
+	}
+	if u := a.upgrade; u != nil {
+		if a.round < u.VoteBefore {
+			status["upgrade-next-protocol-vote-before"] = u.VoteBefore
+			status["upgrade-vote-rounds"] = 10000
+			status["upgrade-votes-required"] = 9000
+			status["upgrade-votes"] = u.YesVotes + u.NoVotes
+			status["upgrade-yes-votes"] = u.YesVotes
+			status["upgrade-no-votes"] = u.NoVotes
+			status["upgrade-delay"] = u.ApplyRound - u.VoteBefore
+		} else if a.round < u.ApplyRound {
+			status["next-version"] = u.NextVersion
+			status["next-version-round"] = u.ApplyRound
+			status["next-version-supported"] = u.Supported
+		}
+		status["stopped-at-unsupported-round"] = u.Stopped
+	}
+	return status
+}
+
+func (a *Algod) getStatus(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	writeJSON(w, http.StatusOK, a.status())
+}
+
+func (a *Algod) waitForBlock(w http.ResponseWriter, r *http.Request) {
+	round, err := strconv.Atoi(r.PathValue("round"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	a.mu.Lock()
+	waiting := a.round <= round && a.catchup == nil
+	a.mu.Unlock()
+
+	if waiting {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(a.RoundTime):
+		}
+		a.mu.Lock()
+		// A stalled node times out with the same round
+		if a.round <= round && !a.stalled {
+			a.advance()
+		}
+		a.mu.Unlock()
+	}
+
+	a.mu.Lock()
+	down := a.down
+	status := a.status()
+	a.mu.Unlock()
+	// A scenario may have taken the node down while waiting
+	if down {
+		hangUp(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (a *Algod) getBlock(w http.ResponseWriter, r *http.Request) {
+	round, err := strconv.Atoi(r.PathValue("round"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if round > a.round {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("ledger does not have entry %d", round))
+		return
+	}
+	if a.failBlocks[round] {
+		delete(a.failBlocks, round)
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to read block %d", round))
+		return
+	}
+	block := map[string]interface{}{
+		"rnd": round,
+		"ts":  a.timestamp(round),
+		"tc":  round * a.TxnsPerRound,
+		"gen": a.GenesisId,
+	}
+	if proposer, ok := a.proposers[round]; ok {
+		block["prp"] = proposer
+		block["pp"] = a.ProposerPayout
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"block": block})
+}
+
+// timestamp is the ts of a block, the lock must be held
+func (a *Algod) timestamp(round int) int64 {
+	if ts, ok := a.timestamps[round]; ok {
+		return ts
+	}
+	return GenesisTimestamp + int64(time.Duration(round)*a.BlockTime/time.Second)
+}
+
+func (a *Algod) getSupply(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	online, total := 0, 0
+	for _, account := range a.accounts {
+		total += account.Amount
+		if account.Status == "Online" {
+			online += account.Amount
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"current_round": a.round,
+		"online-money":  online,
+		"total-money":   total,
+	})
+}
+
+func (a *Algod) transactionParams(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	hash := sha256.Sum256([]byte(a.GenesisId))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"consensus-version": "future",
+		"fee":               0,
+		"genesis-hash":      hash[:],
+		"genesis-id":        a.GenesisId,
+		"last-round":        a.round,
+		"min-fee":           MinFee,
+	})
+}
+
+// rawTransaction checks the signature of the first transaction against the
+// authorized address of its sender, it is confirmed by the next round
+func (a *Algod) rawTransaction(w http.ResponseWriter, r *http.Request) {
+	var stx types.SignedTxn
+	err := msgpack.NewDecoder(r.Body).Decode(&stx)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to decode the signed transaction")
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	txn := stx.Txn
+	hash := sha256.Sum256([]byte(a.GenesisId))
+	if txn.GenesisHash != hash {
+		writeError(w, http.StatusBadRequest, "transaction genesis hash does not match the network")
+		return
+	}
+	if int(txn.FirstValid) > a.round+1 || int(txn.LastValid) <= a.round {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("txn dead: round %d outside of %d--%d", a.round+1, txn.FirstValid, txn.LastValid))
+		return
+	}
+	if uint64(txn.Fee) < MinFee {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("transaction had fee %d, which is less than the minimum %d", txn.Fee, MinFee))
+		return
+	}
+
+	sender := txn.Sender.String()
+	account, ok := a.accounts[sender]
+	if !ok {
+		account = api.Account{Address: sender, Status: "Offline"}
+	}
+	signer := txn.Sender
+	if account.AuthAddr != nil {
+		signer, _ = types.DecodeAddress(*account.AuthAddr)
+	}
+	if stx.AuthAddr != (types.Address{}) && stx.AuthAddr != signer {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("should have been authorized by %s but was actually authorized by %s", signer, stx.AuthAddr))
+		return
+	}
+	message := append([]byte("TX"), msgpack.Encode(txn)...)
+	switch {
+	case !stx.Msig.Blank():
+		if !crypto.VerifyMultisig(signer, message, stx.Msig) {
+			writeError(w, http.StatusBadRequest, "multisig signature verification failed")
+			return
+		}
+	case stx.Sig != (types.Signature{}):
+		if !ed25519.Verify(signer[:], message, stx.Sig[:]) {
+			writeError(w, http.StatusBadRequest, "signature verification failed")
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "transaction is not signed")
+		return
+	}
+
+	txId := crypto.GetTxID(txn)
+	if _, ok := a.transactions[txId]; !ok {
+		a.transactions[txId] = &transaction{Stx: stx}
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"txId": txId})
+}
+
+func (a *Algod) pendingTransactionInformation(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	pending, ok := a.transactions[r.PathValue("txid")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "txn does not exist")
+		return
+	}
+	response := map[string]interface{}{
+		"pool-error": "",
+		"txn": map[string]interface{}{
+			"txn": map[string]interface{}{"type": pending.Stx.Txn.Type, "snd": pending.Stx.Txn.Sender.String()},
+		},
+	}
+	if pending.Round > 0 {
+		response["confirmed-round"] = pending.Round
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// confirm applies a transaction to the ledger, the lock must be held
+func (a *Algod) confirm(stx types.SignedTxn) {
+	if stx.Txn.Type != types.KeyRegistrationTx {
+		return
+	}
+	sender := stx.Txn.Sender.String()
+	account, ok := a.accounts[sender]
+	if !ok {
+		account = api.Account{Address: sender, Status: "Offline"}
+	}
+	a.applyKeyreg(&account, stx.Txn)
+	a.accounts[sender] = account
+}
+
+// applyKeyreg registers the participation key of the node matching the transaction,
+// the lock must be held
+func (a *Algod) applyKeyreg(account *api.Account, txn types.Transaction) {
+	if txn.VotePK == (types.VotePK{}) {
+		account.Status = "Offline"
+		account.Participation = nil
+		return
+	}
+	participation := api.AccountParticipation{
+		SelectionParticipationKey: txn.SelectionPK[:],
+		VoteParticipationKey:      txn.VotePK[:],
+		VoteFirstValid:            int(txn.VoteFirst),
+		VoteLastValid:             int(txn.VoteLast),
+		VoteKeyDilution:           int(txn.VoteKeyDilution),
+	}
+	// The fixture keys are longer than real keys, the transaction holds their prefix
+	for _, key := range a.keys {
+		var vote types.VotePK
+		copy(vote[:], key.Key.VoteParticipationKey)
+		if key.Address == account.Address && vote == txn.VotePK &&
+			key.Key.VoteFirstValid == int(txn.VoteFirst) && key.Key.VoteLastValid == int(txn.VoteLast) {
+			participation = key.Key
+		}
+	}
+	account.Status = "Online"
+	account.Participation = &participation
+	eligible := uint64(txn.Fee) >= IncentiveFee || (account.IncentiveEligible != nil && *account.IncentiveEligible)
+	account.IncentiveEligible = &eligible
+}
+
+func (a *Algod) accountInformation(w http.ResponseWriter, r *http.Request) {
+	address := r.PathValue("address")
+	if _, err := types.DecodeAddress(address); err != nil {
+		writeError(w, http.StatusBadRequest, "failed to parse the address")
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	account, ok := a.accounts[address]
+	if !ok {
+		account = api.Account{Address: address, Status: "Offline"}
+	}
+	account.Round = a.round
+	writeJSON(w, http.StatusOK, account)
+}
+
+func (a *Algod) getParticipationKeys(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	writeJSON(w, http.StatusOK, a.keys)
+}
+
+func (a *Algod) addParticipationKey(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		writeError(w, http.StatusBadRequest, "missing participation key file")
+		return
+	}
+	id := participationId(string(body))
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.findKey(id) >= 0 {
+		writeError(w, http.StatusBadRequest, "participation key already exists")
+		return
+	}
+	a.keys = append(a.keys, api.ParticipationKey{Id: id, Address: FeeSink})
+	writeJSON(w, http.StatusOK, map[string]string{"partId": id})
+}
+
+func (a *Algod) generateParticipationKeys(w http.ResponseWriter, r *http.Request) {
+	address := r.PathValue("address")
+	if _, err := types.DecodeAddress(address); err != nil {
+		writeError(w, http.StatusBadRequest, "failed to parse the address")
+		return
+	}
+	query := r.URL.Query()
+	first, err := strconv.Atoi(query.Get("first"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid first round")
+		return
+	}
+	last, err := strconv.Atoi(query.Get("last"))
+	if err != nil || last <= first {
+		writeError(w, http.StatusBadRequest, "invalid last round")
+		return
+	}
+	dilution := 10000
+	if query.Has("dilution") {
+		dilution, err = strconv.Atoi(query.Get("dilution"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid dilution")
+			return
+		}
+	}
+
+	key := api.ParticipationKey{
+		Address: address,
+		Id:      participationId(fmt.Sprintf("%s-%d-%d", address, first, last)),
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection-" + address),
+			VoteParticipationKey:      []byte("vote-" + address),
+			VoteFirstValid:            first,
+			VoteLastValid:             last,
+			VoteKeyDilution:           dilution,
+		},
+	}
+	// Key generation runs in the background like algod
+	a.generating.Add(1)
+	time.AfterFunc(a.KeyGenDelay, func() {
+		defer a.generating.Done()
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if a.findKey(key.Id) < 0 {
+			a.keys = append(a.keys, key)
+		}
+	})
+	writeJSON(w, http.StatusOK, fmt.Sprintf("participation key generation for %s started", address))
+}
+
+func (a *Algod) getParticipationKeyByID(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i := a.findKey(r.PathValue("id"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "participation id not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, a.keys[i])
+}
+
+func (a *Algod) appendKeys(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i := a.findKey(r.PathValue("id"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "participation id not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, a.keys[i])
+}
+
+func (a *Algod) deleteParticipationKeyByID(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i := a.findKey(r.PathValue("id"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "participation id not found")
+		return
+	}
+	a.keys = append(a.keys[:i], a.keys[i+1:]...)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (a *Algod) startCatchup(w http.ResponseWriter, r *http.Request) {
+	catchpoint := r.PathValue("catchpoint")
+	var round int
+	if _, err := fmt.Sscanf(catchpoint, "%d#", &round); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid catchpoint")
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.catchup != nil {
+		if a.catchup.Catchpoint == catchpoint {
+			writeJSON(w, http.StatusOK, map[string]string{"catchup-message": catchpoint})
+			return
+		}
+		writeError(w, http.StatusBadRequest, "catchup already in progress")
+		return
+	}
+	if min := r.URL.Query().Get("min"); min != "" {
+		rounds, err := strconv.Atoi(min)
+		if err == nil && round-a.round < rounds {
+			writeJSON(w, http.StatusOK, map[string]string{"catchup-message": "the node is already caught up"})
+			return
+		}
+	}
+	a.catchup = &Catchup{
+		Catchpoint:    catchpoint,
+		TotalAccounts: 1000,
+		TotalKvs:      100,
+		TotalBlocks:   1000,
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"catchup-message": catchpoint})
+}
+
+func (a *Algod) abortCatchup(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.catchup == nil || a.catchup.Catchpoint != r.PathValue("catchpoint") {
+		writeError(w, http.StatusBadRequest, "no catchup in progress")
+		return
+	}
+	a.catchup = nil
+	writeJSON(w, http.StatusOK, map[string]string{"catchup-message": r.PathValue("catchpoint")})
+}
+
+// findKey returns the index of a participation key, the lock must be held
+func (a *Algod) findKey(id string) int {
+	for i, key := range a.keys {
+		if key.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// participationId derives a stable id like algod's base32 participation ids
+func participationId(seed string) string {
+	hash := sha256.Sum256([]byte(seed))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(hash[:])
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, api.ErrorResponse{Message: message})
+}
+
+// hangUp closes the connection without a response, like a node that is down
+func hangUp(w http.ResponseWriter) {
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	conn, _, err := hijacker.Hijack()
+	if err == nil {
+		_ = conn.Close()
+	}
+}
+
+// Address derives a valid account address from a seed for fixtures
+func Address(seed string) string {
+	return types.Address(sha256.Sum256([]byte(seed))).String()
+}
@@ -0,0 +1,103 @@
+package fake
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+)
+
+const (
+	// KmdWallet is the wallet of a localnet kmd
+	KmdWallet = "unencrypted-default-wallet"
+	// kmdHandle is the only wallet handle token of the fake kmd
+	kmdHandle = "handle"
+)
+
+// Kmd is a kmd REST API with one wallet holding Accounts, enough to export its keys
+type Kmd struct {
+	*httptest.Server
+
+	// Password opens the wallet
+	Password string
+	Accounts []crypto.Account
+}
+
+// NewKmd starts a fake kmd, close it with Kmd.Close
+func NewKmd(password string, accounts ...crypto.Account) *Kmd {
+	k := &Kmd{Password: password, Accounts: accounts}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/wallets", k.listWallets)
+	mux.HandleFunc("POST /v1/wallet/init", k.initWalletHandle)
+	mux.HandleFunc("POST /v1/wallet/release", k.releaseWalletHandle)
+	mux.HandleFunc("POST /v1/key/list", k.listKeys)
+	mux.HandleFunc("POST /v1/key/export", k.exportKey)
+	k.Server = httptest.NewServer(k.authorize(mux))
+	return k
+}
+
+func (k *Kmd) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-KMD-API-Token") != Token {
+			writeKmdError(w, http.StatusUnauthorized, "invalid API token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (k *Kmd) listWallets(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"wallets": []map[string]string{{"id": "1", "name": KmdWallet}},
+	})
+}
+
+func (k *Kmd) initWalletHandle(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		WalletId string `json:"wallet_id"`
+		Password string `json:"wallet_password"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	if req.WalletId != "1" || req.Password != k.Password {
+		writeKmdError(w, http.StatusUnauthorized, "wrong password")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"wallet_handle_token": kmdHandle})
+}
+
+func (k *Kmd) releaseWalletHandle(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
+
+func (k *Kmd) listKeys(w http.ResponseWriter, r *http.Request) {
+	addresses := make([]string, len(k.Accounts))
+	for i, account := range k.Accounts {
+		addresses[i] = account.Address.String()
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"addresses": addresses})
+}
+
+func (k *Kmd) exportKey(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Address  string `json:"address"`
+		Password string `json:"wallet_password"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	if req.Password != k.Password {
+		writeKmdError(w, http.StatusUnauthorized, "wrong password")
+		return
+	}
+	for _, account := range k.Accounts {
+		if account.Address.String() == req.Address {
+			writeJSON(w, http.StatusOK, map[string]ed25519.PrivateKey{"private_key": account.PrivateKey})
+			return
+		}
+	}
+	writeKmdError(w, http.StatusNotFound, "key does not exist in this wallet")
+}
+
+func writeKmdError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]interface{}{"error": true, "message": message})
+}
@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"github.com/algorandfoundation/algorun-tui/api"
+)
+
+// Scenario changes the fake algod once its Round is reached
+type Scenario struct {
+	Round int
+	Apply func(a *Algod)
+}
+
+// NodeDownAt stops answering requests from a round, use Algod.SetDown to recover
+func NodeDownAt(round int) Scenario {
+	return Scenario{
+		Round: round,
+		Apply: func(a *Algod) {
+			a.down = true
+		},
+	}
+}
+
+// AccountOnlineAt registers the account online with its first participation key
+func AccountOnlineAt(round int, address string) Scenario {
+	return Scenario{
+		Round: round,
+		Apply: func(a *Algod) {
+			account, ok := a.accounts[address]
+			if !ok {
+				account = api.Account{Address: address}
+			}
+			for i, key := range a.keys {
+				if key.Address != address {
+					continue
+				}
+				participation := key.Key
+				account.Participation = &participation
+				effective := a.round + 320
+				a.keys[i].EffectiveFirstValid = &effective
+				a.keys[i].EffectiveLastValid = &key.Key.VoteLastValid
+				break
+			}
+			account.Status = "Online"
+			heartbeat := a.round
+			account.LastHeartbeat = &heartbeat
+			a.accounts[address] = account
+		},
+	}
+}
+
+// AccountOfflineAt registers the account offline
+func AccountOfflineAt(round int, address string) Scenario {
+	return Scenario{
+		Round: round,
+		Apply: func(a *Algod) {
+			account := a.accounts[address]
+			account.Address = address
+			account.Status = "Offline"
+			account.Participation = nil
+			a.accounts[address] = account
+		},
+	}
+}
+
+// BlockProposedAt makes the account the proposer of the block of the round
+func BlockProposedAt(round int, address string) Scenario {
+	return Scenario{
+		Round: round,
+		Apply: func(a *Algod) {
+			a.proposers[round] = address
+			for i, key := range a.keys {
+				if key.Address == address {
+					proposed := round
+					a.keys[i].LastBlockProposal = &proposed
+				}
+			}
+			if account, ok := a.accounts[address]; ok {
+				proposed := round
+				account.LastProposed = &proposed
+				a.accounts[address] = account
+			}
+		},
+	}
+}
+
+// FastCatchupAt starts a fast catchup that completes after a few status requests
+func FastCatchupAt(round int, catchpoint string) Scenario {
+	return Scenario{
+		Round: round,
+		Apply: func(a *Algod) {
+			a.catchup = &Catchup{
+				Catchpoint:    catchpoint,
+				TotalAccounts: 1000,
+				TotalKvs:      100,
+				TotalBlocks:   1000,
+			}
+		},
+	}
+}
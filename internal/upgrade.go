@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"time"
+)
+
+// UpgradeModel is the protocol upgrade reported by algod.Status
+type UpgradeModel struct {
+	// LastVersion is the protocol of the last round
+	LastVersion string
+	// NextVersion is the protocol applied at NextVersionRound, LastVersion without an upgrade
+	NextVersion      string
+	NextVersionRound int
+	// NextVersionSupported is false when the node can't run NextVersion
+	NextVersionSupported bool
+	// StoppedAtUnsupportedRound is true when the node stopped at an upgrade it doesn't support
+	StoppedAtUnsupportedRound bool
+
+	// VoteBefore is the round the vote for the proposed protocol ends, 0 without a vote
+	VoteBefore    int
+	VoteRounds    int
+	Votes         int
+	YesVotes      int
+	NoVotes       int
+	VotesRequired int
+	// Delay is the number of rounds between an approved vote and the upgrade
+	Delay int
+}
+
+// Update applies the upgrade fields of a status response
+func (u *UpgradeModel) Update(status *NodeStatusResponse) {
+	if status == nil {
+		*u = UpgradeModel{}
+		return
+	}
+	*u = UpgradeModel{
+		LastVersion:               status.LastVersion,
+		NextVersion:               status.NextVersion,
+		NextVersionRound:          status.NextVersionRound,
+		NextVersionSupported:      status.NextVersionSupported,
+		StoppedAtUnsupportedRound: status.StoppedAtUnsupportedRound,
+		VoteBefore:                valueOrZero(status.UpgradeNextProtocolVoteBefore),
+		VoteRounds:                valueOrZero(status.UpgradeVoteRounds),
+		Votes:                     valueOrZero(status.UpgradeVotes),
+		YesVotes:                  valueOrZero(status.UpgradeYesVotes),
+		NoVotes:                   valueOrZero(status.UpgradeNoVotes),
+		VotesRequired:             valueOrZero(status.UpgradeVotesRequired),
+		Delay:                     valueOrZero(status.UpgradeDelay),
+	}
+}
+
+// Voting is true while the network votes on a protocol upgrade
+func (u UpgradeModel) Voting(lastRound uint64) bool {
+	return u.VoteBefore > 0 && uint64(u.VoteBefore) > lastRound
+}
+
+// Scheduled is true when an approved upgrade applies after the last round
+func (u UpgradeModel) Scheduled(lastRound uint64) bool {
+	return u.NextVersion != "" && u.NextVersion != u.LastVersion && uint64(u.NextVersionRound) > lastRound
+}
+
+// Unsupported is true when the node can't follow the network after the upgrade
+func (u UpgradeModel) Unsupported() bool {
+	return u.StoppedAtUnsupportedRound || (u.NextVersion != "" && u.NextVersion != u.LastVersion && !u.NextVersionSupported)
+}
+
+// VoteProgress is the share of the VotesRequired voting yes, between 0 and 1
+func (u UpgradeModel) VoteProgress() float64 {
+	return ratio(u.YesVotes, u.VotesRequired)
+}
+
+// RoundsUntil is the number of rounds from the last round to a round, 0 once it passed
+func RoundsUntil(round int, lastRound uint64) int {
+	if round <= 0 || uint64(round) <= lastRound {
+		return 0
+	}
+	return int(uint64(round) - lastRound)
+}
+
+// TimeUntil estimates the time to a round from the average round time
+func TimeUntil(round int, lastRound uint64, roundTime time.Duration) (time.Duration, bool) {
+	rounds := RoundsUntil(round, lastRound)
+	if rounds == 0 || roundTime <= 0 {
+		return 0, false
+	}
+	return (time.Duration(rounds) * roundTime).Round(time.Minute), true
+}
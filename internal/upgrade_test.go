@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+)
+
+func Test_UpgradeModel(t *testing.T) {
+	ctx := context.Background()
+	algod := fake.New(fake.WithRound(1000))
+	defer algod.Close()
+	client := algod.Client()
+	status := StatusModel{Version: "v3.0.0-stable"}
+
+	err := status.Fetch(ctx, client, new(testResponse))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Upgrade.Voting(status.LastRound) || status.Upgrade.Scheduled(status.LastRound) || status.Upgrade.Unsupported() {
+		t.Errorf("expected no upgrade, got %+v", status.Upgrade)
+	}
+
+	algod.SetUpgrade(&fake.Upgrade{NextVersion: "next", VoteBefore: 1100, YesVotes: 45, NoVotes: 5, ApplyRound: 1200})
+	_ = status.Fetch(ctx, client, new(testResponse))
+	upgrade := status.Upgrade
+	if !upgrade.Voting(status.LastRound) || upgrade.YesVotes != 45 || upgrade.VotesRequired != 9000 || upgrade.Delay != 100 {
+		t.Errorf("expected a vote, got %+v", upgrade)
+	}
+	if upgrade.VoteProgress() != 0.005 {
+		t.Errorf("expected 45 of 9000 votes, got %f", upgrade.VoteProgress())
+	}
+
+	algod.Advance(100)
+	_ = status.Fetch(ctx, client, new(testResponse))
+	upgrade = status.Upgrade
+	if upgrade.Voting(status.LastRound) || !upgrade.Scheduled(status.LastRound) || !upgrade.Unsupported() {
+		t.Errorf("expected an unsupported upgrade, got %+v", upgrade)
+	}
+	if eta, ok := TimeUntil(upgrade.NextVersionRound, status.LastRound, time.Second*3); !ok || eta != time.Minute*5 {
+		t.Errorf("expected the upgrade in 5 minutes, got %s", eta)
+	}
+
+	algod.SetUpgrade(&fake.Upgrade{NextVersion: "next", ApplyRound: 1200, Supported: true})
+	_ = status.Fetch(ctx, client, new(testResponse))
+	if !status.Upgrade.Scheduled(status.LastRound) || status.Upgrade.Unsupported() {
+		t.Errorf("expected a supported upgrade, got %+v", status.Upgrade)
+	}
+
+	algod.SetUpgrade(&fake.Upgrade{Stopped: true})
+	_ = status.Fetch(ctx, client, new(testResponse))
+	if !status.Upgrade.Unsupported() {
+		t.Errorf("expected the node to be stopped, got %+v", status.Upgrade)
+	}
+	if _, ok := TimeUntil(900, status.LastRound, time.Second*3); ok {
+		t.Error("expected no time to a past round")
+	}
+}
@@ -0,0 +1,438 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/charmbracelet/log"
+)
+
+// Event is a change detected between two snapshots of the StateModel
+type Event interface {
+	event()
+}
+
+// RoundAdvanced is published when the node reports a new round
+type RoundAdvanced struct {
+	Previous uint64
+	Round    uint64
+}
+
+// KeyAdded is published when a participation key is installed
+type KeyAdded struct {
+	Key api.ParticipationKey
+}
+
+// KeyRemoved is published when a participation key is deleted
+type KeyRemoved struct {
+	Key api.ParticipationKey
+}
+
+// AccountStatusChanged is published when an account goes online or offline
+type AccountStatusChanged struct {
+	Address  string
+	Previous string
+	Status   string
+}
+
+// BlockProposed is published when one of the accounts proposes a block
+type BlockProposed struct {
+	Address string
+	Round   uint64
+	// Payout is the proposer payout in microAlgos
+	Payout int
+}
+
+// AbsenceRiskChanged is published when the suspension risk of an incentive eligible account changes
+type AbsenceRiskChanged struct {
+	Address  string
+	Previous AbsenceRisk
+	Risk     AbsenceRisk
+	Reason   string
+}
+
+// NodeDown is published on the first failure to reach the node
+type NodeDown struct {
+	Err error
+}
+
+// NodeRecovered is published when the node answers again after NodeDown
+type NodeRecovered struct {
+	Round uint64
+}
+
+// NodeStalled is published when a synced node stops producing rounds while it still answers
+type NodeStalled struct {
+	Round uint64
+	Since time.Duration
+}
+
+// NodeResumed is published when the rounds advance again after NodeStalled
+type NodeResumed struct {
+	Round uint64
+}
+
+// ClockSkewChanged is published when the clock skew goes above or back under MaxClockSkew
+type ClockSkewChanged struct {
+	Skew   time.Duration
+	Skewed bool
+}
+
+func (RoundAdvanced) event()        {}
+func (KeyAdded) event()             {}
+func (KeyRemoved) event()           {}
+func (AccountStatusChanged) event() {}
+func (BlockProposed) event()        {}
+func (AbsenceRiskChanged) event()   {}
+func (NodeDown) event()             {}
+func (NodeRecovered) event()        {}
+func (NodeStalled) event()          {}
+func (NodeResumed) event()          {}
+func (ClockSkewChanged) event()     {}
+
+// Update is sent to subscribers with an immutable snapshot of the state
+type Update struct {
+	State  StateModel
+	Events []Event
+	Err    error
+}
+
+// Backoff is an exponential delay with jitter used between failed requests
+type Backoff struct {
+	Min     time.Duration
+	Max     time.Duration
+	attempt int
+}
+
+// Next returns the delay for the next attempt, between half and all of the exponential delay
+func (b *Backoff) Next() time.Duration {
+	delay := b.Min << b.attempt
+	if delay > b.Max || delay <= 0 {
+		delay = b.Max
+	} else {
+		b.attempt++
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// Reset starts over from the minimum delay
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
+
+// Watcher follows the node and publishes snapshots of the StateModel.
+// The state is owned by the Run goroutine, subscribers only receive copies
+type Watcher struct {
+	Backoff Backoff
+	// CatchupInterval is the polling interval while the node is in fast catchup
+	CatchupInterval time.Duration
+	// Recorder stores the updates when it is set
+	Recorder *Recorder
+	// StallFactor is the number of average round times without a round before the node is stalled
+	StallFactor int
+	// Reference is a node of the same network the sync progress is compared with, nil without one
+	Reference api.ClientWithResponsesInterface
+	// ReferenceInterval is the polling interval of the Reference while the node is syncing
+	ReferenceInterval time.Duration
+	// Logger reports the errors the watcher recovers from, nil to discard them
+	Logger *log.Logger
+
+	state  StateModel
+	client api.ClientWithResponsesInterface
+	down   bool
+	// scanned is the last round read for proposals
+	scanned uint64
+
+	mu          sync.Mutex
+	subscribers map[*subscription]struct{}
+}
+
+type subscription struct {
+	ch   chan Update
+	done chan struct{}
+	once sync.Once
+}
+
+// NewWatcher creates a Watcher starting from a copy of the state
+func NewWatcher(state *StateModel, client api.ClientWithResponsesInterface) *Watcher {
+	return &Watcher{
+		Backoff:           Backoff{Min: time.Millisecond * 500, Max: time.Second * 30},
+		CatchupInterval:   time.Second * 10,
+		StallFactor:       DefaultStallFactor,
+		ReferenceInterval: time.Second * 10,
+		state:             state.Snapshot(),
+		client:            client,
+		subscribers:       make(map[*subscription]struct{}),
+	}
+}
+
+// Subscribe returns a channel of updates and a function to unsubscribe.
+// Updates are delivered in order, a slow subscriber slows down the watcher.
+// The channel is closed when Run returns
+func (w *Watcher) Subscribe(buffer int) (<-chan Update, func()) {
+	sub := &subscription{ch: make(chan Update, buffer), done: make(chan struct{})}
+	w.mu.Lock()
+	w.subscribers[sub] = struct{}{}
+	w.mu.Unlock()
+	return sub.ch, func() {
+		sub.once.Do(func() {
+			close(sub.done)
+		})
+		w.mu.Lock()
+		delete(w.subscribers, sub)
+		w.mu.Unlock()
+	}
+}
+
+// Run watches the node until the context is cancelled, then closes every subscription
+func (w *Watcher) Run(ctx context.Context) error {
+	defer w.closeSubscribers()
+	s := &w.state
+	if s.Metrics.Window == 0 {
+		s.Metrics.Window = 100
+	}
+	httpPkg := s.Http
+	if httpPkg == nil {
+		httpPkg = new(HttpPkg)
+	}
+
+	err := s.Status.Fetch(ctx, w.client, httpPkg)
+	if err != nil {
+		w.fail(ctx, err)
+	} else {
+		w.publish(ctx, s.Snapshot(), nil)
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		previous := s.Snapshot()
+
+		if s.Status.State == FastCatchupState {
+			// The blocks before the catchpoint are never read
+			w.scanned = 0
+			if !sleep(ctx, w.CatchupInterval) {
+				continue
+			}
+			err := s.Status.Fetch(ctx, w.client, httpPkg)
+			if err != nil {
+				w.fail(ctx, err)
+				continue
+			}
+			w.succeed(ctx, previous)
+			continue
+		}
+
+		status, err := w.client.WaitForBlockWithResponse(ctx, int(s.Status.LastRound))
+		if err == nil && status.StatusCode() != 200 {
+			err = errors.New(status.Status())
+		}
+		if err != nil {
+			w.fail(ctx, err)
+			continue
+		}
+
+		// Update Status
+		s.Status.Update(status.JSON200.LastRound, status.JSON200.CatchupTime, status.JSON200.Catchpoint, status.JSON200.UpgradeNodeVote)
+		s.Status.Catchpoint.Update(status.JSON200, time.Now())
+		s.Status.Upgrade.Update(status.JSON200)
+		s.Status.UpdateHealth(status.JSON200, s.Metrics.RoundTime, w.StallFactor)
+		w.updateSync(ctx)
+
+		// Fetch Keys
+		s.UpdateKeys(ctx)
+
+		// Look for blocks proposed by the accounts once the node is synced. The rounds missed while the node
+		// was down or syncing, and after a failed block, are read in batches on the next rounds
+		if s.Status.State == StableState {
+			if w.scanned == 0 {
+				w.scanned = previous.Status.LastRound
+			}
+			w.scanned, err = s.UpdateProposals(ctx, w.client, w.scanned)
+			if err != nil && w.Logger != nil {
+				w.Logger.Warn("failed to read the block proposals", "round", w.scanned+1, "err", err)
+			}
+		}
+
+		// Run Round Averages and RX/TX every 5 rounds
+		if s.Status.State != SyncingState &&
+			(s.Status.LastRound%5 == 0 || (s.Status.LastRound > 100 && s.Metrics.RoundTime.Seconds() == 0)) {
+			bm, err := GetBlockMetrics(ctx, w.client, s.Status.LastRound, s.Metrics.Window)
+			if err != nil {
+				w.fail(ctx, err)
+				continue
+			}
+			s.Metrics.RoundTime = bm.AvgTime
+			s.Metrics.TPS = bm.TPS
+			s.Status.Health.CheckClock(time.Now(), bm.LastTime)
+			s.UpdateMetricsFromRPC(ctx, w.client)
+			s.UpdateSupply(ctx, w.client)
+			s.Metrics.History.Push(s.Metrics)
+		}
+
+		w.succeed(ctx, previous)
+	}
+}
+
+// updateSync measures the sync rate and reads the Reference while the node is syncing
+func (w *Watcher) updateSync(ctx context.Context) {
+	s := &w.state
+	if s.Status.State != SyncingState {
+		s.Status.Sync.Reset()
+		return
+	}
+	now := time.Now()
+	s.Status.Sync.Sample(s.Status.LastRound, now)
+	if w.Reference != nil && now.Sub(s.Status.Sync.CheckedAt) >= w.ReferenceInterval {
+		// The node is fine when the reference can't be reached, the last network round is kept
+		_ = s.Status.Sync.UpdateNetwork(ctx, w.Reference, now)
+	}
+}
+
+// succeed resets the backoff and publishes the changes since the previous snapshot
+func (w *Watcher) succeed(ctx context.Context, previous StateModel) {
+	w.Backoff.Reset()
+	current := w.state.Snapshot()
+	events := Diff(previous, current)
+	if w.down {
+		w.down = false
+		events = append([]Event{NodeRecovered{Round: current.Status.LastRound}}, events...)
+	}
+	w.publish(ctx, current, nil, events...)
+}
+
+// fail marks the node as down, publishes the error and waits before the next attempt
+func (w *Watcher) fail(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	w.state.Status.State = "DOWN"
+	var events []Event
+	if !w.down {
+		w.down = true
+		events = append(events, NodeDown{Err: err})
+	}
+	w.publish(ctx, w.state.Snapshot(), err, events...)
+	sleep(ctx, w.Backoff.Next())
+}
+
+func (w *Watcher) publish(ctx context.Context, state StateModel, err error, events ...Event) {
+	update := Update{State: state, Events: events, Err: err}
+	if w.Recorder != nil {
+		// A full disk must not stop the watcher
+		_ = w.Recorder.Write(update)
+	}
+	w.mu.Lock()
+	subscribers := make([]*subscription, 0, len(w.subscribers))
+	for sub := range w.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	w.mu.Unlock()
+	for _, sub := range subscribers {
+		select {
+		case sub.ch <- update:
+		case <-sub.done:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// closeSubscribers is only called by Run, so no publish is in flight
+func (w *Watcher) closeSubscribers() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for sub := range w.subscribers {
+		delete(w.subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// Diff lists the events between two snapshots
+func Diff(previous StateModel, current StateModel) []Event {
+	var events []Event
+	if current.Status.LastRound > previous.Status.LastRound {
+		events = append(events, RoundAdvanced{Previous: previous.Status.LastRound, Round: current.Status.LastRound})
+	}
+	if current.Status.Health.Stalled != previous.Status.Health.Stalled {
+		if current.Status.Health.Stalled {
+			events = append(events, NodeStalled{Round: current.Status.LastRound, Since: current.Status.Health.TimeSinceLastRound})
+		} else {
+			events = append(events, NodeResumed{Round: current.Status.LastRound})
+		}
+	}
+	if current.Status.Health.Skewed() != previous.Status.Health.Skewed() {
+		events = append(events, ClockSkewChanged{Skew: current.Status.Health.ClockSkew, Skewed: current.Status.Health.Skewed()})
+	}
+
+	previousKeys := make(map[string]api.ParticipationKey)
+	if previous.ParticipationKeys != nil {
+		for _, key := range *previous.ParticipationKeys {
+			previousKeys[key.Id] = key
+		}
+	}
+	// Keys are unknown before the first fetch and when the last request failed
+	if previous.ParticipationKeys != nil && current.ParticipationKeys != nil {
+		currentKeys := make(map[string]bool)
+		for _, key := range *current.ParticipationKeys {
+			currentKeys[key.Id] = true
+			if _, ok := previousKeys[key.Id]; !ok {
+				events = append(events, KeyAdded{Key: key})
+			}
+		}
+		for _, key := range *previous.ParticipationKeys {
+			if !currentKeys[key.Id] {
+				events = append(events, KeyRemoved{Key: key})
+			}
+		}
+	}
+
+	addresses := make([]string, 0, len(current.Accounts))
+	for address := range current.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		account := current.Accounts[address]
+		before, ok := previous.Accounts[address]
+		if ok && before.Status != account.Status {
+			events = append(events, AccountStatusChanged{Address: address, Previous: before.Status, Status: account.Status})
+		}
+		// The risk is unknown while the account is offline or not refreshed
+		if account.Absence != nil {
+			risk := HealthyRisk
+			if before.Absence != nil {
+				risk = before.Absence.Risk
+			}
+			if risk != account.Absence.Risk {
+				events = append(events, AbsenceRiskChanged{Address: address, Previous: risk, Risk: account.Absence.Risk, Reason: account.Absence.Reason})
+			}
+		}
+		// The newest proposals are at the end of the timeline
+		proposals := current.Proposals[address]
+		count := min(proposals.Count-previous.Proposals[address].Count, len(proposals.Timeline))
+		for _, proposal := range proposals.Timeline[len(proposals.Timeline)-max(0, count):] {
+			events = append(events, BlockProposed{Address: address, Round: proposal.Round, Payout: proposal.Payout})
+		}
+	}
+	return events
+}
+
+// sleep waits for the duration, returning false when the context is cancelled first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,282 @@
+package internal
+
+import (
+	"context"
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_Backoff(t *testing.T) {
+	backoff := Backoff{Min: time.Second, Max: time.Second * 8}
+	for i, expected := range []time.Duration{1, 2, 4, 8, 8} {
+		expected *= time.Second
+		delay := backoff.Next()
+		if delay < expected/2 || delay > expected {
+			t.Errorf("attempt %d: expected a delay between %s and %s, got %s", i, expected/2, expected, delay)
+		}
+	}
+	backoff.Reset()
+	if delay := backoff.Next(); delay > time.Second {
+		t.Errorf("expected the delay to reset, got %s", delay)
+	}
+}
+
+func Test_Diff(t *testing.T) {
+	keys := []api.ParticipationKey{{Id: "123"}, {Id: "456"}}
+	nextKeys := []api.ParticipationKey{{Id: "456"}, {Id: "789"}}
+	proposals := Proposals{}.Add(Proposal{Round: 5, Proposer: "ABC"})
+	previous := StateModel{
+		Status:            StatusModel{LastRound: 10},
+		ParticipationKeys: &keys,
+		Accounts:          map[string]Account{"ABC": {Status: "Offline"}},
+		Proposals:         map[string]Proposals{"ABC": proposals},
+	}
+	current := StateModel{
+		Status:            StatusModel{LastRound: 11},
+		ParticipationKeys: &nextKeys,
+		Accounts: map[string]Account{
+			"ABC": {Status: "Online"},
+			"DEF": {Status: "Online", Absence: &AbsenceCheck{Risk: AtRisk, Reason: "no proposal for 60 of 100 rounds"}},
+		},
+		Proposals: map[string]Proposals{
+			"ABC": proposals.Add(Proposal{Round: 9, Proposer: "ABC", Payout: 100}).Add(Proposal{Round: 10, Proposer: "ABC"}),
+		},
+	}
+	events := Diff(previous, current)
+	expected := []Event{
+		RoundAdvanced{Previous: 10, Round: 11},
+		KeyAdded{Key: api.ParticipationKey{Id: "789"}},
+		KeyRemoved{Key: api.ParticipationKey{Id: "123"}},
+		AccountStatusChanged{Address: "ABC", Previous: "Offline", Status: "Online"},
+		BlockProposed{Address: "ABC", Round: 9, Payout: 100},
+		BlockProposed{Address: "ABC", Round: 10},
+		AbsenceRiskChanged{Address: "DEF", Previous: HealthyRisk, Risk: AtRisk, Reason: "no proposal for 60 of 100 rounds"},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %v", len(expected), events)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(events[i], expected[i]) {
+			t.Errorf("expected %+v, got %+v", expected[i], events[i])
+		}
+	}
+
+	// Health changes are reported once
+	current.Status.Health = HealthModel{Stalled: true, TimeSinceLastRound: time.Minute, ClockSkew: time.Minute}
+	events = Diff(previous, current)
+	if !reflect.DeepEqual(events[1], NodeStalled{Round: 11, Since: time.Minute}) ||
+		!reflect.DeepEqual(events[2], ClockSkewChanged{Skew: time.Minute, Skewed: true}) {
+		t.Errorf("expected the node to be stalled and skewed, got %+v", events)
+	}
+	events = Diff(current, previous)
+	if !reflect.DeepEqual(events[0], NodeResumed{Round: 10}) || !reflect.DeepEqual(events[1], ClockSkewChanged{}) {
+		t.Errorf("expected the node to resume, got %+v", events)
+	}
+	current.Status.Health = HealthModel{}
+
+	// Unknown keys are not reported as removed or added
+	current.ParticipationKeys = nil
+	for _, event := range append(Diff(previous, current), Diff(current, previous)...) {
+		switch event.(type) {
+		case KeyAdded, KeyRemoved:
+			t.Errorf("expected no key events without keys, got %+v", event)
+		}
+	}
+}
+
+func Test_WatcherEvents(t *testing.T) {
+	address := fake.Address("watcher")
+	algod := fake.New(
+		fake.WithRound(200),
+		fake.WithRoundTime(time.Millisecond*20),
+		fake.WithKeys(api.ParticipationKey{
+			Address: address,
+			Id:      "watcher-key",
+			Key:     api.AccountParticipation{VoteFirstValid: 100, VoteLastValid: 10000, VoteKeyDilution: 100},
+		}),
+		fake.WithScenarios(
+			fake.AccountOnlineAt(203, address),
+			fake.NodeDownAt(206),
+		),
+	)
+	defer algod.Close()
+	client := algod.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	watcher := NewWatcher(&StateModel{
+		Status: StatusModel{Version: "v3.0.0-stable", State: StableState},
+		Client: client,
+	}, client)
+	watcher.Backoff = Backoff{Min: time.Millisecond * 10, Max: time.Millisecond * 50}
+	updates, unsubscribe := watcher.Subscribe(16)
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	// Wait for an event, acting on the node when needed
+	wait := func(match func(Event) bool) {
+		t.Helper()
+		waitForEvent(ctx, t, updates, match)
+	}
+
+	wait(func(e Event) bool { _, ok := e.(RoundAdvanced); return ok })
+	wait(func(e Event) bool {
+		changed, ok := e.(AccountStatusChanged)
+		return ok && changed.Address == address && changed.Status == "Online"
+	})
+	wait(func(e Event) bool { _, ok := e.(NodeDown); return ok })
+	algod.SetDown(false)
+	wait(func(e Event) bool { _, ok := e.(NodeRecovered); return ok })
+
+	err := DeletePartKey(ctx, client, "watcher-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wait(func(e Event) bool {
+		removed, ok := e.(KeyRemoved)
+		return ok && removed.Key.Id == "watcher-key"
+	})
+
+	// Unsubscribing without draining does not block the watcher
+	blocked, unsubscribeBlocked := watcher.Subscribe(0)
+	var round uint64
+	waitForEvent(ctx, t, blocked, func(e Event) bool {
+		advanced, ok := e.(RoundAdvanced)
+		round = advanced.Round
+		return ok
+	})
+	// The next update waits for the unread subscription until it unsubscribes,
+	// the rounds after it are only published once it is released
+	unsubscribeBlocked()
+	after := func(e Event) bool { advanced, ok := e.(RoundAdvanced); return ok && advanced.Round > round }
+	wait(after)
+	wait(after)
+	select {
+	case <-blocked:
+		t.Error("expected no update after unsubscribing")
+	default:
+	}
+
+	unsubscribe()
+	// Unsubscribing twice is a no-op
+	unsubscribe()
+}
+
+func Test_WatcherCancel(t *testing.T) {
+	algod := fake.New()
+	algod.SetDown(true)
+	defer algod.Close()
+	client := algod.Client()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher := NewWatcher(&StateModel{Status: StatusModel{Version: "v3.0.0-stable"}}, client)
+	updates, _ := watcher.Subscribe(0)
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	update := <-updates
+	if update.Err == nil || update.State.Status.State != "DOWN" {
+		t.Errorf("expected the node to be down, got %s", update.State.Status.State)
+	}
+	// Cancelling interrupts the backoff
+	cancel()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the watcher did not stop")
+	}
+	if _, ok := <-updates; ok {
+		t.Error("expected the subscription to be closed")
+	}
+}
+
+// waitForEvent reads the updates until an event matches
+func waitForEvent(ctx context.Context, t *testing.T, updates <-chan Update, match func(Event) bool) {
+	t.Helper()
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				t.Fatal("the watcher stopped")
+			}
+			for _, event := range update.Events {
+				if match(event) {
+					return
+				}
+			}
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for an event")
+		}
+	}
+}
+
+func Test_WatcherStall(t *testing.T) {
+	algod := fake.New(fake.WithRound(200), fake.WithRoundTime(time.Millisecond*20))
+	// The average round time is a second
+	algod.BlockTime = time.Second
+	defer algod.Close()
+	client := algod.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	watcher := NewWatcher(&StateModel{
+		Status: StatusModel{Version: "v3.0.0-stable", State: StableState},
+		Client: client,
+	}, client)
+	watcher.StallFactor = 1
+	updates, unsubscribe := watcher.Subscribe(16)
+	defer unsubscribe()
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	// Stall once the round time is measured
+	waitForEvent(ctx, t, updates, func(e Event) bool { advanced, ok := e.(RoundAdvanced); return ok && advanced.Round > 205 })
+	algod.SetStalled(true)
+	waitForEvent(ctx, t, updates, func(e Event) bool {
+		stalled, ok := e.(NodeStalled)
+		return ok && stalled.Since > time.Second
+	})
+	algod.SetStalled(false)
+	waitForEvent(ctx, t, updates, func(e Event) bool { _, ok := e.(NodeResumed); return ok })
+}
+
+func Test_WatcherClockSkew(t *testing.T) {
+	algod := fake.New(fake.WithRound(200), fake.WithRoundTime(time.Millisecond*20))
+	defer algod.Close()
+	algod.SetClock(0)
+	client := algod.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	watcher := NewWatcher(&StateModel{
+		Status: StatusModel{Version: "v3.0.0-stable", State: StableState},
+		Client: client,
+	}, client)
+	updates, unsubscribe := watcher.Subscribe(16)
+	defer unsubscribe()
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+
+	// The blocks come from a minute ahead of the local clock
+	algod.SetClock(time.Minute)
+	waitForEvent(ctx, t, updates, func(e Event) bool {
+		changed, ok := e.(ClockSkewChanged)
+		return ok && changed.Skewed && changed.Skew < -MaxClockSkew
+	})
+	algod.SetClock(0)
+	waitForEvent(ctx, t, updates, func(e Event) bool {
+		changed, ok := e.(ClockSkewChanged)
+		return ok && !changed.Skewed
+	})
+}
@@ -2,11 +2,14 @@ package main
 
 import (
 	"github.com/algorandfoundation/algorun-tui/cmd"
+	"os"
 )
 
 func main() {
-	err := cmd.Execute()
-	if err != nil {
-		return
-	}
+	os.Exit(run())
+}
+
+// run executes the command and returns its exit code
+func run() int {
+	return cmd.ExitCode(cmd.Execute())
 }
@@ -8,5 +8,8 @@ import (
 func Test_Main(t *testing.T) {
 	viper.Set("algod-token", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
 	viper.Set("algod-endpoint", "http://localhost:8080")
-	main()
+	// The TUI fails without a TTY
+	if code := run(); code == 0 {
+		t.Error("expected a non-zero exit code")
+	}
 }
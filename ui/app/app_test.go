@@ -2,9 +2,19 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/internal/test"
 	uitest "github.com/algorandfoundation/algorun-tui/ui/internal/test"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -64,3 +74,189 @@ func Test_EmitDeleteKey(t *testing.T) {
 	}
 
 }
+
+func Test_EmitExportKeyreg(t *testing.T) {
+	dir := t.TempDir()
+	key := api.ParticipationKey{
+		Id:      "123",
+		Address: "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE",
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: []byte("selection"),
+			VoteParticipationKey:      []byte("vote"),
+			VoteFirstValid:            1000,
+			VoteLastValid:             2000,
+			VoteKeyDilution:           100,
+		},
+	}
+	state := uitest.GetState(test.GetClient(false))
+	res := EmitExportKeyreg(state, key, false, dir)()
+	evt, ok := res.(KeyregExported)
+	if !ok || evt.Err != nil || evt.Id != "123" || evt.Offline {
+		t.Fatalf("expected the online transaction to be exported, got %+v", res)
+	}
+	if evt.Path != filepath.Join(dir, "123-online.txn") {
+		t.Errorf("unexpected path %s", evt.Path)
+	}
+	data, _ := os.ReadFile(evt.Path)
+	var stx types.SignedTxn
+	err := msgpack.Decode(data, &stx)
+	if err != nil || stx.Txn.Sender.String() != key.Address || stx.Txn.VoteLast != 2000 || stx.Txn.FirstValid != 1337 {
+		t.Errorf("unexpected transaction %+v, %v", stx.Txn, err)
+	}
+
+	if stx.Txn.Fee == internal.IncentiveFee {
+		t.Errorf("expected the suggested fee without a fee policy, got %d", stx.Txn.Fee)
+	}
+
+	// The online registration pays the fee of the policy once the account can pay it
+	state.FeePolicy = internal.AlwaysFee
+	state.Accounts[key.Address] = internal.Account{Address: key.Address, Amount: 1000000}
+	evt = EmitExportKeyreg(state, key, false, dir)().(KeyregExported)
+	if evt.Err == nil || evt.Path != "" {
+		t.Errorf("expected the account to be unable to pay the fee, got %+v", evt)
+	}
+	state.Accounts[key.Address] = internal.Account{Address: key.Address, Amount: 3000000}
+	evt = EmitExportKeyreg(state, key, false, dir)().(KeyregExported)
+	data, _ = os.ReadFile(evt.Path)
+	err = msgpack.Decode(data, &stx)
+	if evt.Err != nil || err != nil || stx.Txn.Fee != internal.IncentiveFee {
+		t.Errorf("expected the incentive fee, got %d, %v and %v", stx.Txn.Fee, evt.Err, err)
+	}
+	// Offline registrations pay the suggested fee
+	evt = EmitExportKeyreg(state, key, true, dir)().(KeyregExported)
+	data, _ = os.ReadFile(evt.Path)
+	err = msgpack.Decode(data, &stx)
+	if evt.Err != nil || err != nil || stx.Txn.Fee == internal.IncentiveFee {
+		t.Errorf("expected the suggested fee offline, got %d, %v and %v", stx.Txn.Fee, evt.Err, err)
+	}
+
+	res = EmitExportKeyreg(uitest.GetState(test.GetClient(true)), key, true, dir)()
+	evt = res.(KeyregExported)
+	if evt.Err == nil || !evt.Offline || evt.Path != "" {
+		t.Errorf("expected an error, got %+v", evt)
+	}
+}
+
+func Test_EmitSubmitKeyreg(t *testing.T) {
+	dir := t.TempDir()
+	address := "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE"
+	sender, _ := types.DecodeAddress(address)
+	path := filepath.Join(dir, "keyreg.stxn")
+	key := api.ParticipationKey{Id: "KEY", Address: address}
+	signed := types.SignedTxn{
+		Sig: types.Signature{1},
+		Txn: types.Transaction{
+			Type:   types.KeyRegistrationTx,
+			Header: types.Header{Sender: sender, FirstValid: 1337, LastValid: 2337},
+		},
+	}
+	_ = os.WriteFile(path, msgpack.Encode(signed), 0600)
+
+	res := EmitSubmitKeyreg(context.Background(), test.GetClient(false), path, key, true)()
+	evt, ok := res.(KeyregSubmitted)
+	if !ok || evt.Source != SubmitModal || evt.Err != nil || evt.Address != address || evt.Key.Id != "KEY" ||
+		!evt.Offline || evt.TxId != "TXID" || evt.Round != 10 {
+		t.Fatalf("expected the transaction to be confirmed, got %+v", res)
+	}
+
+	// The offline transaction doesn't register the key
+	evt = EmitSubmitKeyreg(context.Background(), test.GetClient(false), path, key, false)().(KeyregSubmitted)
+	if evt.Err == nil || evt.TxId != "" {
+		t.Errorf("expected a transaction without the key to be refused, got %+v", evt)
+	}
+
+	// The transaction of another account
+	other := api.ParticipationKey{Id: "KEY", Address: "ABC"}
+	evt = EmitSubmitKeyreg(context.Background(), test.GetClient(false), path, other, true)().(KeyregSubmitted)
+	if evt.Err == nil || evt.TxId != "" {
+		t.Errorf("expected the transaction of another account to be refused, got %+v", evt)
+	}
+
+	_ = os.WriteFile(path, msgpack.Encode(types.SignedTxn{Txn: signed.Txn}), 0600)
+	evt = EmitSubmitKeyreg(context.Background(), test.GetClient(false), path, key, true)().(KeyregSubmitted)
+	if evt.Err == nil || evt.TxId != "" {
+		t.Errorf("expected an unsigned transaction to be refused, got %+v", evt)
+	}
+
+	evt = EmitSubmitKeyreg(context.Background(), test.GetClient(false), filepath.Join(dir, "missing"), key, true)().(KeyregSubmitted)
+	if evt.Err == nil {
+		t.Errorf("expected a missing file to fail, got %+v", evt)
+	}
+}
+
+// shortener records the short link requests and the body of the last one
+type shortener struct {
+	internal.HttpPkgInterface
+	posts int
+	body  internal.OnlineShortLinkBody
+}
+
+func (s *shortener) Post(url string, bodyType string, body io.Reader) (*http.Response, error) {
+	s.posts++
+	_ = json.NewDecoder(body).Decode(&s.body)
+	return &http.Response{Body: io.NopCloser(strings.NewReader(`{"id":"ID"}`))}, nil
+}
+
+func Test_EmitCreateShortLink_Fee(t *testing.T) {
+	state := uitest.GetState(nil)
+	links := new(shortener)
+	state.Http = links
+	state.Status.Network = "tuinet-v1.0"
+	key := state.ParticipationKeys
+	part := (*key)[0]
+	account := state.Accounts[part.Address]
+	account.Amount = internal.IncentiveFee
+	account.MinBalance = 100000
+	state.Accounts[part.Address] = account
+
+	state.FeePolicy = internal.NeverFee
+	// The service is only reached by the command, off the update loop
+	cmd := EmitCreateShortLink(false, &part, state)
+	if links.posts != 0 {
+		t.Fatal("expected the short link to be created by the command")
+	}
+	res := cmd()
+	if _, ok := res.(internal.ShortLinkResponse); !ok || links.posts != 1 || links.body.Fee != 0 {
+		t.Fatalf("expected the suggested fee, got %+v %+v", res, links.body)
+	}
+
+	state.FeePolicy = internal.AlwaysFee
+	res = EmitCreateShortLink(false, &part, state)()
+	if err, ok := res.(error); !ok || !strings.Contains(err.Error(), "needs 2 ALGO above its minimum balance of 0.1 ALGO") {
+		t.Fatalf("expected the balance to be too low, got %+v", res)
+	}
+
+	account.Amount = internal.IncentiveFee + account.MinBalance
+	state.Accounts[part.Address] = account
+	res = EmitCreateShortLink(false, &part, state)()
+	if _, ok := res.(internal.ShortLinkResponse); !ok || links.body.Fee != internal.IncentiveFee {
+		t.Errorf("expected the incentive fee, got %+v %+v", res, links.body)
+	}
+}
+
+// downShortener is a short link service that can't be reached
+type downShortener struct {
+	internal.HttpPkgInterface
+}
+
+func (downShortener) Post(url string, bodyType string, body io.Reader) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func Test_EmitCreateShortLink_Fallback(t *testing.T) {
+	state := uitest.GetState(nil)
+	state.Http = new(downShortener)
+	state.Status.Network = "tuinet-v1.0"
+	part := (*state.ParticipationKeys)[0]
+
+	res, ok := EmitCreateShortLink(false, &part, state)().(internal.ShortLinkResponse)
+	expected, _ := internal.ToWalletLink(state.Network(), false, 0, part)
+	if !ok || res.Fallback != expected || internal.ToShortLink("", res) != expected {
+		t.Errorf("expected the online Lora link, got %+v", res)
+	}
+	res, ok = EmitCreateShortLink(true, &part, state)().(internal.ShortLinkResponse)
+	expected, _ = internal.ToWalletLink(state.Network(), true, 0, part)
+	if !ok || res.Fallback != expected {
+		t.Errorf("expected the offline Lora link, got %+v", res)
+	}
+}
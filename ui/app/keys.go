@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/algorandfoundation/algorun-tui/api"
@@ -71,3 +74,123 @@ func GenerateCmd(account string, rangeType internal.RangeType, duration int, sta
 	}
 
 }
+
+// KeyregExported is emitted once an unsigned key registration for the key Id was written to Path
+type KeyregExported struct {
+	Id      string
+	Offline bool
+	Path    string
+	Err     error
+}
+
+// EmitExportKeyreg writes the unsigned online or offline key registration of a key
+// to a goal compatible .txn file in dir, the working directory when empty.
+// The online registration pays the fee of the FeePolicy
+func EmitExportKeyreg(state *internal.StateModel, key api.ParticipationKey, offline bool, dir string) tea.Cmd {
+	return func() tea.Msg {
+		msg := KeyregExported{Id: key.Id, Offline: offline}
+		adj := "online"
+		online := &key
+		if offline {
+			adj = "offline"
+			online = nil
+		}
+		fee, err := keyregFee(state, key.Address, offline)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		params, err := internal.GetSuggestedParams(state.Context, state.Client)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		txn, err := internal.MakeKeyreg(key.Address, online, params, fee)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		msg.Path = filepath.Join(dir, fmt.Sprintf("%s-%s.txn", key.Id, adj))
+		msg.Err = os.WriteFile(msg.Path, internal.EncodeUnsigned(txn), 0600)
+		return msg
+	}
+}
+
+// KeyregSubmitted is emitted once a signed key registration of Address was confirmed in Round
+type KeyregSubmitted struct {
+	// Source is the modal that submitted the transaction, it gets the result even when another modal is open
+	Source  ModalType
+	Address string
+	// Key is the participation key registered by the transaction, or taken offline when Offline is set
+	Key     *api.ParticipationKey
+	Offline bool
+	TxId    string
+	Round   int
+	Err     error
+}
+
+// EmitSubmitKeyreg broadcasts the signed key registration of a file and waits for its confirmation.
+// Files that don't register the key, or take its account offline, are refused before they are sent
+func EmitSubmitKeyreg(ctx context.Context, client api.ClientWithResponsesInterface, path string, key api.ParticipationKey, offline bool) tea.Cmd {
+	return func() tea.Msg {
+		msg := KeyregSubmitted{Source: SubmitModal, Key: &key, Offline: offline}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		stx, err := internal.DecodeSignedKeyreg(data)
+		if err != nil {
+			msg.Err = fmt.Errorf("invalid key registration %s: %w", filepath.Base(path), err)
+			return msg
+		}
+		msg.Address = stx.Txn.Sender.String()
+		if err = internal.MatchKeyreg(stx.Txn, key, offline); err != nil {
+			msg.Err = fmt.Errorf("%s: %w", filepath.Base(path), err)
+			return msg
+		}
+		msg.TxId, err = internal.SubmitTransaction(ctx, client, data)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		msg.Round, msg.Err = internal.WaitForConfirmation(ctx, client, msg.TxId, internal.ConfirmationRounds)
+		return msg
+	}
+}
+
+// EmitSignKeyreg signs an online key registration for the key, or an offline one, with the
+// dev signer of a local network and waits for its confirmation
+func EmitSignKeyreg(state *internal.StateModel, key api.ParticipationKey, offline bool) tea.Cmd {
+	return func() tea.Msg {
+		msg := KeyregSubmitted{Source: InfoModal, Address: key.Address, Key: &key, Offline: offline}
+		account, ok := state.Accounts[key.Address]
+		if !ok {
+			account = internal.Account{Address: key.Address}
+		}
+		online := &key
+		if offline {
+			online = nil
+		}
+		fee, err := keyregFee(state, key.Address, offline)
+		if err != nil {
+			msg.Err = err
+			return msg
+		}
+		msg.TxId, msg.Err = state.Signer.SubmitKeyreg(state.Context, state.Client, state.Network(), account, online, fee)
+		if msg.Err != nil {
+			return msg
+		}
+		msg.Round, msg.Err = internal.WaitForConfirmation(state.Context, state.Client, msg.TxId, internal.ConfirmationRounds)
+		return msg
+	}
+}
+
+// keyregFee is the fee the FeePolicy sets for an online key registration of the address,
+// offline registrations pay the suggested fee
+func keyregFee(state *internal.StateModel, address string, offline bool) (uint64, error) {
+	if offline {
+		return 0, nil
+	}
+	return state.CheckKeyregFee(address)
+}
@@ -0,0 +1,13 @@
+package app
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// PinnedMetrics are the series keys shown in the status header
+type PinnedMetrics []string
+
+// EmitPinnedMetrics updates the metrics pinned to the status header
+func EmitPinnedMetrics(keys []string) tea.Cmd {
+	return func() tea.Msg {
+		return PinnedMetrics(append([]string{}, keys...))
+	}
+}
@@ -15,6 +15,7 @@ const (
 	TransactionModal ModalType = "transaction"
 	GenerateModal    ModalType = "generate"
 	ExceptionModal   ModalType = "exception"
+	SubmitModal      ModalType = "submit"
 )
 
 func EmitShowModal(modal ModalType) tea.Cmd {
@@ -29,6 +30,8 @@ type ModalEvent struct {
 	Address string
 	Err     *error
 	Type    ModalType
+	// Action is the node control of a ConfirmModal
+	Action NodeAction
 }
 
 func EmitModalEvent(event ModalEvent) tea.Cmd {
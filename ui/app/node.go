@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// NodeAction is a request to control the local algod process
+type NodeAction string
+
+const (
+	StartNode   NodeAction = "start"
+	StopNode    NodeAction = "stop"
+	RestartNode NodeAction = "restart"
+)
+
+// NodeActionFinished is emitted once a NodeAction completes
+type NodeActionFinished struct {
+	Action NodeAction
+	Err    error
+}
+
+// EmitNodeAction runs the action against the node in the background
+func EmitNodeAction(ctx context.Context, node *internal.Node, action NodeAction) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		switch action {
+		case StartNode:
+			_, err = node.Start()
+		case StopNode:
+			err = node.Stop(ctx)
+		case RestartNode:
+			_, err = node.Restart(ctx)
+		}
+		return NodeActionFinished{
+			Action: action,
+			Err:    err,
+		}
+	}
+}
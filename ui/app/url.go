@@ -1,55 +1,54 @@
 package app
 
 import (
-	"encoding/base64"
-	"strings"
-
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// EmitCreateShortLink creates the short link of an online or offline key registration,
+// the wallet link of the network is used when the short link service fails or doesn't know the network
 func EmitCreateShortLink(offline bool, part *api.ParticipationKey, state *internal.StateModel) tea.Cmd {
 	if part == nil || state == nil {
 		return nil
 	}
-
-	var loraNetwork = strings.Replace(strings.Replace(state.Status.Network, "-v1.0", "", 1), "-v1", "", 1)
-	if loraNetwork == "dockernet" || loraNetwork == "tuinet" {
-		loraNetwork = "localnet"
-	}
+	network := state.Network()
 
 	if offline {
-		res, err := internal.GetOfflineShortLink(state.Http, internal.OfflineShortLinkBody{
-			Account: part.Address,
-			Network: loraNetwork,
+		body := internal.NewOfflineShortLinkBody(network, *part)
+		return shortLink(network, body.Query(), func() (internal.ShortLinkResponse, error) {
+			return internal.GetOfflineShortLink(state.Http, state.ShortLinkURL, body)
 		})
-		if err != nil {
-			return func() tea.Msg {
-				return err
-			}
-		}
-		return func() tea.Msg {
-			return res
-		}
 	}
 
-	res, err := internal.GetOnlineShortLink(state.Http, internal.OnlineShortLinkBody{
-		Account:          part.Address,
-		VoteKeyB64:       base64.RawURLEncoding.EncodeToString(part.Key.VoteParticipationKey),
-		SelectionKeyB64:  base64.RawURLEncoding.EncodeToString(part.Key.SelectionParticipationKey),
-		StateProofKeyB64: base64.RawURLEncoding.EncodeToString(*part.Key.StateProofKey),
-		VoteFirstValid:   part.Key.VoteFirstValid,
-		VoteLastValid:    part.Key.VoteLastValid,
-		KeyDilution:      part.Key.VoteKeyDilution,
-		Network:          loraNetwork,
-	})
+	// Refuse to offer a transaction the account can't pay for
+	fee, err := state.CheckKeyregFee(part.Address)
 	if err != nil {
 		return func() tea.Msg {
 			return err
 		}
 	}
+	body := internal.NewOnlineShortLinkBody(network, *part)
+	body.Fee = fee
+	return shortLink(network, body.Query(), func() (internal.ShortLinkResponse, error) {
+		return internal.GetOnlineShortLink(state.Http, state.ShortLinkURL, body)
+	})
+}
+
+// shortLink posts the registration to the short link service when it knows the network,
+// the msg is the wallet link of the query otherwise. The post runs in the command, off the update loop
+func shortLink(network internal.Network, query string, post func() (internal.ShortLinkResponse, error)) tea.Cmd {
 	return func() tea.Msg {
-		return res
+		if network.Lora != "" {
+			res, err := post()
+			if err == nil {
+				return res
+			}
+		}
+		link, err := network.WalletLink(query)
+		if err != nil {
+			return err
+		}
+		return internal.ShortLinkResponse{Fallback: link}
 	}
 }
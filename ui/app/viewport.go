@@ -9,7 +9,9 @@ type Page string
 
 const (
 	AccountsPage Page = "accounts"
+	AccountPage  Page = "account"
 	KeysPage     Page = "keys"
+	MetricsPage  Page = "metrics"
 )
 
 func EmitShowPage(page Page) tea.Cmd {
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"fmt"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"strings"
+	"time"
+)
+
+// CatchupViewModel displays the progress of a fast catchup
+type CatchupViewModel struct {
+	Data           *internal.StateModel
+	TerminalWidth  int
+	TerminalHeight int
+	// Now is the time of the last update, used for the elapsed time and ETA
+	Now time.Time
+}
+
+// Init has no I/O right now
+func (m CatchupViewModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update is called when the user interacts with the render
+func (m CatchupViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+// HandleMessage is called when the user interacts with the render
+func (m CatchupViewModel) HandleMessage(msg tea.Msg) (CatchupViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	// Is it a heartbeat of the latest round?
+	case internal.StateModel:
+		m.Data = &msg
+		m.Now = time.Now()
+	// Is it a resize event?
+	case tea.WindowSizeMsg:
+		m.TerminalWidth = msg.Width
+		m.TerminalHeight = msg.Height
+	}
+	return m, nil
+}
+
+// progressBar renders a bar of a fixed width filled to the ratio of value and total
+func progressBar(width int, value int, total int) string {
+	percent := 0.0
+	if total > 0 {
+		percent = min(1, float64(value)/float64(total))
+	}
+	filled := int(float64(width) * percent)
+	return style.Green.Render(strings.Repeat("█", filled)) +
+		style.Cyan.Render(strings.Repeat("░", max(0, width-filled))) +
+		fmt.Sprintf(" %3.0f%%", percent*100)
+}
+
+// View handles the render cycle
+func (m CatchupViewModel) View() string {
+	if m.TerminalWidth <= 0 {
+		return "Loading...\n\n\n\n\n\n"
+	}
+	catchpoint := m.Data.Status.Catchpoint
+
+	labelWidth := 22
+	barWidth := max(10, m.TerminalWidth-labelWidth-20)
+	row := func(label string, value int, total int) string {
+		return lipgloss.JoinHorizontal(lipgloss.Left,
+			style.Blue.Width(labelWidth).Render(" "+label),
+			progressBar(barWidth, value, total),
+			fmt.Sprintf("  %d/%d", value, total),
+		)
+	}
+
+	elapsed := "--"
+	if !catchpoint.StartedAt.IsZero() && !m.Now.IsZero() {
+		elapsed = m.Now.Sub(catchpoint.StartedAt).Round(time.Second).String()
+	}
+	eta := "--"
+	if remaining, ok := catchpoint.ETA(m.Now); ok {
+		eta = remaining.String()
+	}
+
+	return style.WithTitle("Fast Catchup", style.ApplyBorder(max(0, m.TerminalWidth-2), 9, "5").Render(
+		lipgloss.JoinVertical(lipgloss.Left,
+			style.Blue.Render(" Catchpoint: ")+catchpoint.Label,
+			"",
+			row("Accounts Processed", catchpoint.ProcessedAccounts, catchpoint.TotalAccounts),
+			row("Accounts Verified", catchpoint.VerifiedAccounts, catchpoint.TotalAccounts),
+			row("KVs Processed", catchpoint.ProcessedKvs, catchpoint.TotalKvs),
+			row("KVs Verified", catchpoint.VerifiedKvs, catchpoint.TotalKvs),
+			row("Blocks Acquired", catchpoint.AcquiredBlocks, catchpoint.TotalBlocks),
+			"",
+			style.Blue.Render(" Elapsed: ")+elapsed+style.Blue.Render("  ETA: ")+eta,
+		)))
+}
+
+// MakeCatchupViewModel constructs the model to be used in a tea.Program
+func MakeCatchupViewModel(state *internal.StateModel) CatchupViewModel {
+	return CatchupViewModel{
+		Data:          state,
+		TerminalWidth: 80,
+		Now:           time.Now(),
+	}
+}
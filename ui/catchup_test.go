@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"bytes"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/charmbracelet/x/exp/teatest"
+	"testing"
+	"time"
+)
+
+var catchupStartedAt = time.Unix(1000, 0)
+
+var catchupState = &internal.StateModel{
+	Status: internal.StatusModel{
+		LastRound: 1337,
+		State:     internal.FastCatchupState,
+		Catchpoint: internal.CatchpointModel{
+			Label:             "4420000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA",
+			TotalAccounts:     1000,
+			ProcessedAccounts: 1000,
+			VerifiedAccounts:  500,
+			TotalKvs:          200,
+			ProcessedKvs:      200,
+			VerifiedKvs:       100,
+			TotalBlocks:       1000,
+			AcquiredBlocks:    0,
+			StartedAt:         catchupStartedAt,
+		},
+	},
+}
+
+var catchupViewSnapshots = map[string]CatchupViewModel{
+	"Visible": {
+		Data:           catchupState,
+		TerminalWidth:  120,
+		TerminalHeight: 40,
+		Now:            catchupStartedAt.Add(time.Minute),
+	},
+	"Small": {
+		Data:           catchupState,
+		TerminalWidth:  60,
+		TerminalHeight: 40,
+		Now:            catchupStartedAt.Add(time.Minute),
+	},
+	"Starting": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				State: internal.FastCatchupState,
+				Catchpoint: internal.CatchpointModel{
+					Label:     "4420000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA",
+					StartedAt: catchupStartedAt,
+				},
+			},
+		},
+		TerminalWidth:  120,
+		TerminalHeight: 40,
+		Now:            catchupStartedAt,
+	},
+	"Loading": {
+		Data:          catchupState,
+		TerminalWidth: 0,
+	},
+}
+
+func Test_CatchupSnapshot(t *testing.T) {
+	for name, model := range catchupViewSnapshots {
+		t.Run(name, func(t *testing.T) {
+			got := ansi.Strip(model.View())
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func Test_CatchupMessages(t *testing.T) {
+	m := MakeCatchupViewModel(catchupState)
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(120, 40),
+	)
+
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Blocks Acquired"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(*catchupState)
+	tm.Send(tea.QuitMsg{})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"fmt"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"strings"
+)
+
+// DashboardViewModel charts the metrics history across the whole terminal
+type DashboardViewModel struct {
+	Data           *internal.StateModel
+	TerminalWidth  int
+	TerminalHeight int
+}
+
+// Init has no I/O right now
+func (m DashboardViewModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update is called when the user interacts with the render
+func (m DashboardViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+// HandleMessage is called when the user interacts with the render
+func (m DashboardViewModel) HandleMessage(msg tea.Msg) (DashboardViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	// Is it a heartbeat of the latest round?
+	case internal.StateModel:
+		m.Data = &msg
+	// Is it a resize event?
+	case tea.WindowSizeMsg:
+		m.TerminalWidth = msg.Width
+		m.TerminalHeight = msg.Height
+	}
+	return m, nil
+}
+
+// chart renders a titled panel with the history and its min/avg/max
+func chart(title string, history internal.History, format func(float64) string, width int, height int, color string) string {
+	innerWidth := max(0, width-2)
+	innerHeight := max(0, height-2)
+
+	stats := " Waiting for data..."
+	if last, ok := history.Last(); ok {
+		low, avg, high := history.Stats()
+		stats = style.Blue.Render(" now ") + format(last) +
+			style.Blue.Render("  min ") + format(low) +
+			style.Blue.Render("  avg ") + format(avg) +
+			style.Blue.Render("  max ") + format(high)
+	}
+
+	graph := style.BrailleChart(history.Values(), max(0, innerWidth-2), max(0, innerHeight-2))
+	lines := strings.Split(graph, "\n")
+	for i, line := range lines {
+		lines[i] = " " + style.Cyan.Render(line)
+	}
+
+	return style.WithTitle(title, style.ApplyBorder(innerWidth, innerHeight, color).Render(
+		lipgloss.JoinVertical(lipgloss.Left,
+			stats,
+			"",
+			strings.Join(lines, "\n"),
+		)))
+}
+
+// View handles the render cycle
+func (m DashboardViewModel) View() string {
+	if m.TerminalWidth <= 0 || m.Data == nil {
+		return "Loading...\n\n\n\n\n\n"
+	}
+	history := m.Data.Metrics.History
+	width := m.TerminalWidth / 2
+	// The last line holds the controls
+	height := max(0, m.TerminalHeight-1) / 2
+
+	seconds := func(v float64) string { return fmt.Sprintf("%.2fs", v) }
+	tps := func(v float64) string { return fmt.Sprintf("%.2f", v) }
+	bytes := func(v float64) string { return strings.TrimSpace(getBitRate(int(v))) }
+
+	top := lipgloss.JoinHorizontal(lipgloss.Top,
+		chart("Round Time", history.RoundTime, seconds, width, height, "5"),
+		chart("TPS", history.TPS, tps, m.TerminalWidth-width, height, "5"),
+	)
+	bottom := lipgloss.JoinHorizontal(lipgloss.Top,
+		chart("RX", history.RX, bytes, width, height, "6"),
+		chart("TX", history.TX, bytes, m.TerminalWidth-width, height, "6"),
+	)
+	return lipgloss.JoinVertical(lipgloss.Left,
+		top,
+		bottom,
+		style.Cyan.Render(fmt.Sprintf(" %d of %d samples | (d)ashboard to close", history.RoundTime.Len(), history.RoundTime.Cap())),
+	)
+}
+
+// MakeDashboardViewModel constructs the model to be used in a tea.Program
+func MakeDashboardViewModel(state *internal.StateModel) DashboardViewModel {
+	return DashboardViewModel{
+		Data:          state,
+		TerminalWidth: 80,
+	}
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"bytes"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	uitest "github.com/algorandfoundation/algorun-tui/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/charmbracelet/x/exp/teatest"
+	"testing"
+	"time"
+)
+
+// getHistory builds a repeatable history of the status metrics
+func getHistory() internal.MetricsHistory {
+	history := internal.NewMetricsHistory(60)
+	for i := 0; i < 40; i++ {
+		history.Push(internal.MetricsModel{
+			RoundTime: time.Millisecond * time.Duration(2800+(i%7)*50),
+			TPS:       float64(10 + (i*3)%11),
+			RX:        1024 * (10 + (i*5)%13),
+			TX:        2048 * (5 + (i*2)%9),
+		})
+	}
+	return history
+}
+
+var dashboardViewSnapshots = map[string]DashboardViewModel{
+	"Visible": {
+		Data: &internal.StateModel{
+			Status:  internal.StatusModel{LastRound: 1337, State: internal.StableState},
+			Metrics: internal.MetricsModel{History: getHistory()},
+		},
+		TerminalWidth:  120,
+		TerminalHeight: 30,
+	},
+	"Empty": {
+		Data: &internal.StateModel{
+			Status:  internal.StatusModel{LastRound: 1337, State: internal.StableState},
+			Metrics: internal.MetricsModel{History: internal.NewMetricsHistory(60)},
+		},
+		TerminalWidth:  80,
+		TerminalHeight: 20,
+	},
+	"Loading": {
+		Data:           &internal.StateModel{},
+		TerminalWidth:  0,
+		TerminalHeight: 0,
+	},
+}
+
+func Test_DashboardSnapshot(t *testing.T) {
+	for name, model := range dashboardViewSnapshots {
+		t.Run(name, func(t *testing.T) {
+			got := ansi.Strip(model.View())
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func Test_ViewportDashboard(t *testing.T) {
+	client := test.GetClient(false)
+	state := uitest.GetState(client)
+	state.Metrics.History = getHistory()
+	m, err := NewViewportViewModel(state, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(160, 40),
+	)
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("40 of 60 samples"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	// Page keys are ignored until the dashboard is closed
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Accounts"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("q"),
+	})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
@@ -32,7 +32,6 @@ func GetState(client api.ClientWithResponsesInterface) *internal.StateModel {
 		Accounts:          nil,
 		ParticipationKeys: &mock2.Keys,
 		Admin:             false,
-		Watching:          false,
 		Client:            client,
 		Http:              new(internal.HttpPkg),
 		Context:           context.Background(),
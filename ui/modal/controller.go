@@ -16,6 +16,7 @@ func (m ViewModel) Init() tea.Cmd {
 		m.transactionModal.Init(),
 		m.confirmModal.Init(),
 		m.generateModal.Init(),
+		m.submitModal.Init(),
 	)
 }
 func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
@@ -36,9 +37,12 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 		m.State = &msg
 		m.transactionModal.State = &msg
 		m.infoModal.State = &msg
+		m.submitModal.State = &msg
 
 		// When the state changes, and we are displaying a valid QR Code/Transaction Modal
-		if m.Type == app.TransactionModal && m.transactionModal.Participation != nil {
+		// or the key information of a registration signed by the dev signer
+		signed := m.Type == app.InfoModal && m.infoModal.Submitted != nil && m.infoModal.Submitted.Err == nil
+		if (m.Type == app.TransactionModal || signed) && m.transactionModal.Participation != nil {
 			acct, ok := msg.Accounts[m.Address]
 			// If the previous state is not active
 			if ok {
@@ -47,6 +51,7 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 						acct.Participation.VoteFirstValid == m.transactionModal.Participation.Key.VoteFirstValid {
 						m.SetActive(true)
 						m.infoModal.Active = true
+						m.transactionModal.Submitted = nil
 						m.SetType(app.InfoModal)
 					}
 				} else {
@@ -54,6 +59,7 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 						m.SetActive(false)
 						m.infoModal.Active = false
 						m.transactionModal.Active = false
+						m.transactionModal.Submitted = nil
 						m.SetType(app.InfoModal)
 					}
 				}
@@ -87,7 +93,14 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 			case app.ExceptionModal:
 				m.Open = false
 			case app.ConfirmModal:
-				m.SetType(app.InfoModal)
+				// The node controls are confirmed from the dashboard, not from a key
+				if m.confirmModal.Action != "" {
+					m.Open = false
+				} else {
+					m.SetType(app.InfoModal)
+				}
+			case app.SubmitModal:
+				m.SetType(app.TransactionModal)
 			}
 		}
 
@@ -95,12 +108,46 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 			m.SetKey(msg.Key)
 			m.SetAddress(msg.Address)
 			m.SetActive(msg.Active)
+			m.SetAction(msg.Action)
 			m.SetType(msg.Type)
 		}
 
 	// Handle Modal Type
 	case app.ModalType:
 		m.SetType(msg)
+		// List the files again, a signed transaction may have been added
+		if msg == app.SubmitModal {
+			cmds = append(cmds, m.submitModal.Init())
+		}
+
+	// Follow the submitted key registration until the key is active
+	case app.KeyregSubmitted:
+		// The dev signer of the info modal reports on the modal itself, errors are raised when it was left
+		if msg.Source == app.InfoModal {
+			m.infoModal, cmd = m.infoModal.HandleMessage(msg)
+			cmds = append(cmds, cmd)
+			if msg.Err != nil && (!m.Open || m.Type != app.InfoModal) {
+				m.Open = true
+				m.exceptionModal.Message = "Signing failed: " + msg.Err.Error()
+				m.SetType(app.ExceptionModal)
+			}
+			return &m, tea.Batch(cmds...)
+		}
+		m.submitModal, cmd = m.submitModal.HandleMessage(msg)
+		cmds = append(cmds, cmd)
+		if msg.Err != nil {
+			m.Open = true
+			m.exceptionModal.Message = "Submit failed: " + msg.Err.Error()
+			m.SetType(app.ExceptionModal)
+			return &m, tea.Batch(cmds...)
+		}
+		// Follow the account and the key of the transaction, another key may have been opened meanwhile
+		m.SetKey(msg.Key)
+		m.SetAddress(msg.Address)
+		m.SetActive(msg.Offline)
+		m.transactionModal.Submitted = &msg
+		m.SetType(app.TransactionModal)
+		return &m, tea.Batch(cmds...)
 
 	// Handle Confirmation Dialog Delete Finished
 	case app.DeleteFinished:
@@ -134,6 +181,8 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 		cmds = append(cmds, cmd)
 		m.exceptionModal, cmd = m.exceptionModal.HandleMessage(modalMsg)
 		cmds = append(cmds, cmd)
+		m.submitModal, cmd = m.submitModal.HandleMessage(modalMsg)
+		cmds = append(cmds, cmd)
 		return &m, tea.Batch(cmds...)
 	}
 
@@ -150,6 +199,8 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 		m.confirmModal, cmd = m.confirmModal.HandleMessage(msg)
 	case app.GenerateModal:
 		m.generateModal, cmd = m.generateModal.HandleMessage(msg)
+	case app.SubmitModal:
+		m.submitModal, cmd = m.submitModal.HandleMessage(msg)
 	}
 	cmds = append(cmds, cmd)
 
@@ -3,6 +3,7 @@ package modal
 import (
 	"bytes"
 	"errors"
+	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
 	"github.com/algorandfoundation/algorun-tui/ui/app"
@@ -73,6 +74,81 @@ func Test_Snapshot(t *testing.T) {
 	})
 }
 
+func Test_Submit(t *testing.T) {
+	model := New(lipgloss.NewStyle().Width(80).Height(80).Render(""), true, test.GetState(nil))
+	model.SetKey(&mock.Keys[0])
+	model.SetAddress(mock.Keys[0].Address)
+	model.SetType(app.TransactionModal)
+
+	model, _ = model.HandleMessage(app.SubmitModal)
+	if model.Type != app.SubmitModal {
+		t.Fatalf("expected the submit modal, got %s", model.Type)
+	}
+	model, _ = model.HandleMessage(app.ModalEvent{Type: app.CancelModal})
+	if model.Type != app.TransactionModal || !model.Open {
+		t.Errorf("expected to go back to the transaction, got %s", model.Type)
+	}
+
+	model, _ = model.HandleMessage(app.KeyregSubmitted{Source: app.SubmitModal, Err: errors.New("transaction rejected")})
+	if model.Type != app.ExceptionModal || model.exceptionModal.Message != "Submit failed: transaction rejected" {
+		t.Errorf("expected the error, got %s %s", model.Type, model.exceptionModal.Message)
+	}
+
+	// The result goes to the transaction of its key even when the user opened another one meanwhile
+	other := mock.Keys[0]
+	other.Id = "OTHER"
+	other.Address = "ABC"
+	model.SetKey(&other)
+	model.SetAddress(other.Address)
+	model.SetActive(true)
+	model.SetType(app.InfoModal)
+	model, _ = model.HandleMessage(app.KeyregSubmitted{Source: app.SubmitModal, Address: mock.Keys[0].Address, Key: &mock.Keys[0], TxId: "TXID", Round: 10})
+	if model.Type != app.TransactionModal || model.transactionModal.Submitted == nil || model.infoModal.Submitted != nil {
+		t.Fatalf("expected the transaction modal to follow the key, got %s", model.Type)
+	}
+	if model.Address != mock.Keys[0].Address || model.transactionModal.Participation.Id != mock.Keys[0].Id || model.transactionModal.Active {
+		t.Fatalf("expected the submitted key to be followed, got %s %s", model.Address, model.transactionModal.Participation.Id)
+	}
+
+	// The state updates switch to the active key
+	state := *test.GetState(nil)
+	acct := state.Accounts[mock.Keys[0].Address]
+	acct.Participation = &api.AccountParticipation{VoteFirstValid: mock.Keys[0].Key.VoteFirstValid}
+	state.Accounts[mock.Keys[0].Address] = acct
+	model, _ = model.HandleMessage(state)
+	if model.Type != app.InfoModal || !model.infoModal.Active {
+		t.Errorf("expected the key to be active, got %s", model.Type)
+	}
+}
+
+func Test_SignedKeyreg(t *testing.T) {
+	model := New(lipgloss.NewStyle().Width(80).Height(80).Render(""), true, test.GetState(nil))
+	model.SetKey(&mock.Keys[0])
+	model.SetAddress(mock.Keys[0].Address)
+	model.SetType(app.InfoModal)
+
+	model, _ = model.HandleMessage(app.KeyregSubmitted{Source: app.InfoModal, Address: mock.Keys[0].Address, TxId: "TXID", Round: 10})
+	if model.Type != app.InfoModal || model.infoModal.Submitted == nil {
+		t.Fatalf("expected the info modal to report the transaction, got %s", model.Type)
+	}
+
+	state := *test.GetState(nil)
+	acct := state.Accounts[mock.Keys[0].Address]
+	acct.Participation = &api.AccountParticipation{VoteFirstValid: mock.Keys[0].Key.VoteFirstValid}
+	state.Accounts[mock.Keys[0].Address] = acct
+	model, _ = model.HandleMessage(state)
+	if model.Type != app.InfoModal || !model.infoModal.Active {
+		t.Errorf("expected the key to be active, got %s", model.Type)
+	}
+
+	// A signing error is raised when the user left the info modal
+	model.SetType(app.TransactionModal)
+	model, _ = model.HandleMessage(app.KeyregSubmitted{Source: app.InfoModal, Address: mock.Keys[0].Address, Err: errors.New("signer locked")})
+	if model.Type != app.ExceptionModal || model.exceptionModal.Message != "Signing failed: signer locked" || model.transactionModal.Submitted != nil {
+		t.Errorf("expected the signing error, got %s %s", model.Type, model.exceptionModal.Message)
+	}
+}
+
 func Test_Messages(t *testing.T) {
 	model := New(lipgloss.NewStyle().Width(80).Height(80).Render(""), true, test.GetState(nil))
 	model.SetKey(&mock.Keys[0])
@@ -8,6 +8,7 @@ import (
 	"github.com/algorandfoundation/algorun-tui/ui/modals/exception"
 	"github.com/algorandfoundation/algorun-tui/ui/modals/generate"
 	"github.com/algorandfoundation/algorun-tui/ui/modals/info"
+	"github.com/algorandfoundation/algorun-tui/ui/modals/submit"
 	"github.com/algorandfoundation/algorun-tui/ui/modals/transaction"
 )
 
@@ -36,6 +37,7 @@ type ViewModel struct {
 	confirmModal     *confirm.ViewModel
 	generateModal    *generate.ViewModel
 	exceptionModal   *exception.ViewModel
+	submitModal      *submit.ViewModel
 
 	// Current Component Data
 	title       string
@@ -50,14 +52,20 @@ func (m *ViewModel) SetAddress(address string) {
 }
 func (m *ViewModel) SetKey(key *api.ParticipationKey) {
 	m.infoModal.Participation = key
+	m.infoModal.Submitted = nil
 	m.confirmModal.ActiveKey = key
 	m.transactionModal.Participation = key
+	m.submitModal.Participation = key
+}
+func (m *ViewModel) SetAction(action app.NodeAction) {
+	m.confirmModal.SetAction(action)
 }
 func (m *ViewModel) SetActive(active bool) {
 	m.infoModal.Active = active
 	m.infoModal.UpdateState()
 	m.transactionModal.Active = active
 	m.transactionModal.UpdateState()
+	m.submitModal.Active = active
 }
 
 func (m *ViewModel) SetShortLink(res internal.ShortLinkResponse) {
@@ -88,6 +96,10 @@ func (m *ViewModel) SetType(modal app.ModalType) {
 		m.title = m.exceptionModal.Title
 		m.controls = m.exceptionModal.Controls
 		m.borderColor = m.exceptionModal.BorderColor
+	case app.SubmitModal:
+		m.title = m.submitModal.Title
+		m.controls = m.submitModal.Controls
+		m.borderColor = m.submitModal.BorderColor
 	}
 }
 
@@ -107,6 +119,7 @@ func New(parent string, open bool, state *internal.StateModel) *ViewModel {
 		confirmModal:     confirm.New(state),
 		generateModal:    generate.New("", state),
 		exceptionModal:   exception.New(""),
+		submitModal:      submit.New(state),
 
 		Type:        app.InfoModal,
 		controls:    "",
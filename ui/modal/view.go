@@ -22,6 +22,8 @@ func (m ViewModel) View() string {
 		render = m.generateModal.View()
 	case app.ExceptionModal:
 		render = m.exceptionModal.View()
+	case app.SubmitModal:
+		render = m.submitModal.View()
 	}
 	width := lipgloss.Width(render) + 2
 	height := lipgloss.Height(render)
@@ -16,7 +16,25 @@ type ViewModel struct {
 	Controls    string
 	BorderColor string
 	ActiveKey   *api.ParticipationKey
-	Data        *internal.StateModel
+	// Action is the node control to confirm, the key is deleted when it is empty
+	Action app.NodeAction
+	Data   *internal.StateModel
+}
+
+// actionTitles names the node controls in the title
+var actionTitles = map[app.NodeAction]string{
+	app.StartNode:   "Start Node",
+	app.StopNode:    "Stop Node",
+	app.RestartNode: "Restart Node",
+}
+
+// SetAction switches between confirming a node control and a key deletion
+func (m *ViewModel) SetAction(action app.NodeAction) {
+	m.Action = action
+	m.Title = "Delete Key"
+	if title, ok := actionTitles[action]; ok {
+		m.Title = title
+	}
 }
 
 func New(state *internal.StateModel) *ViewModel {
@@ -49,6 +67,11 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 			var (
 				cmds []tea.Cmd
 			)
+			if m.Action != "" {
+				cmds = append(cmds, app.EmitNodeAction(m.Data.Context, m.Data.Node, m.Action))
+				cmds = append(cmds, app.EmitModalEvent(app.ModalEvent{Type: app.CloseModal}))
+				return &m, tea.Batch(cmds...)
+			}
 			cmds = append(cmds, app.EmitDeleteKey(m.Data.Context, m.Data.Client, m.ActiveKey.Id))
 			return &m, tea.Batch(cmds...)
 		}
@@ -59,6 +82,9 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 	return &m, nil
 }
 func (m ViewModel) View() string {
+	if m.Action != "" && m.Data.Node != nil {
+		return renderNodeConfirmationModal(m.Action, m.Data.Node)
+	}
 	if m.ActiveKey == nil {
 		return "No key selected"
 	}
@@ -75,3 +101,11 @@ func renderDeleteConfirmationModal(partKey *api.ParticipationKey) string {
 		partKey.Id,
 	))
 }
+
+func renderNodeConfirmationModal(action app.NodeAction, node *internal.Node) string {
+	return lipgloss.NewStyle().Padding(1).Render(lipgloss.JoinVertical(lipgloss.Center,
+		"Are you sure you want to "+string(action)+" the node?\n",
+		style.Cyan.Render("Data Directory:"),
+		node.DataDir,
+	))
+}
@@ -2,7 +2,9 @@ package confirm
 
 import (
 	"bytes"
+	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
 	"github.com/algorandfoundation/algorun-tui/ui/internal/test"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/ansi"
@@ -28,12 +30,42 @@ func Test_New(t *testing.T) {
 		t.Errorf("expected cmd to be non-nil")
 	}
 }
+func Test_NodeAction(t *testing.T) {
+	state := test.GetState(nil)
+	state.Node = &internal.Node{Binary: "algod-does-not-exist", DataDir: "/var/lib/algorand"}
+	m := New(state)
+	m.SetAction(app.StopNode)
+	if m.Title != "Stop Node" {
+		t.Errorf("expected the Stop Node title, got %s", m.Title)
+	}
+	m, cmd := m.HandleMessage(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("y"),
+	})
+	if cmd == nil {
+		t.Errorf("expected cmd to be non-nil")
+	}
+	m.SetAction("")
+	if m.Title != "Delete Key" {
+		t.Errorf("expected the Delete Key title, got %s", m.Title)
+	}
+}
+
 func Test_Snapshot(t *testing.T) {
 	t.Run("NoKey", func(t *testing.T) {
 		model := New(test.GetState(nil))
 		got := ansi.Strip(model.View())
 		golden.RequireEqual(t, []byte(got))
 	})
+	t.Run("NodeAction", func(t *testing.T) {
+		state := test.GetState(nil)
+		state.Node = &internal.Node{DataDir: "/var/lib/algorand"}
+		model := New(state)
+		model.SetAction(app.RestartNode)
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 40})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
 	t.Run("Visible", func(t *testing.T) {
 		model := New(test.GetState(nil))
 		model.ActiveKey = &mock.Keys[0]
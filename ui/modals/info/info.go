@@ -1,6 +1,7 @@
 package info
 
 import (
+	"fmt"
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/ui/app"
@@ -20,6 +21,10 @@ type ViewModel struct {
 	Active        bool
 	Participation *api.ParticipationKey
 	State         *internal.StateModel
+	// Signing is true while the dev signer registers the key
+	Signing bool
+	// Submitted is the last key registration of the dev signer
+	Submitted *app.KeyregSubmitted
 }
 
 func New(state *internal.StateModel) *ViewModel {
@@ -55,7 +60,16 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 			}
 		case "o":
 			return &m, app.EmitCreateShortLink(m.Active, m.Participation, m.State)
+		case "s":
+			if m.CanSign() && !m.Signing {
+				m.Signing = true
+				m.Submitted = nil
+				return &m, app.EmitSignKeyreg(m.State, *m.Participation, m.Active)
+			}
 		}
+	case app.KeyregSubmitted:
+		m.Signing = false
+		m.Submitted = &msg
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
@@ -63,20 +77,37 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 	m.UpdateState()
 	return &m, nil
 }
+
+// CanSign is true when the dev signer of a local network holds the key of the account
+func (m ViewModel) CanSign() bool {
+	if m.Participation == nil || m.State == nil || m.State.Signer == nil || !m.State.Network().Local {
+		return false
+	}
+	account, ok := m.State.Accounts[m.Participation.Address]
+	if !ok {
+		account = internal.Account{Address: m.Participation.Address}
+	}
+	return m.State.Signer.CanSign(account)
+}
+
 func (m *ViewModel) UpdateState() {
 	if m.Participation == nil {
 		return
 	}
 	accountStatus := m.State.Accounts[m.Participation.Address].Status
+	sign := ""
+	if m.CanSign() {
+		sign = " | " + style.Yellow.Render("(s)ign")
+	}
 
 	if accountStatus == "Online" && m.Active {
 		m.BorderColor = "1"
-		m.Controls = "( take " + style.Red.Render(style.Red.Render("(o)ffline")) + " )"
+		m.Controls = "( take " + style.Red.Render(style.Red.Render("(o)ffline")) + sign + " )"
 	}
 
 	if !m.Active {
 		m.BorderColor = "3"
-		m.Controls = "( " + style.Red.Render("(d)elete") + " | take " + style.Green.Render("(o)nline") + " )"
+		m.Controls = "( " + style.Red.Render("(d)elete") + " | take " + style.Green.Render("(o)nline") + sign + " )"
 	}
 }
 func (m ViewModel) View() string {
@@ -92,7 +123,7 @@ func (m ViewModel) View() string {
 	voteLastValid := style.Purple("Vote Last Valid: ") + utils.IntToStr(m.Participation.Key.VoteLastValid)
 	voteKeyDilution := style.Purple("Vote Key Dilution: ") + utils.IntToStr(m.Participation.Key.VoteKeyDilution)
 
-	return ansi.Hardwrap(lipgloss.JoinVertical(lipgloss.Left,
+	lines := []string{
 		"",
 		account,
 		id,
@@ -105,6 +136,28 @@ func (m ViewModel) View() string {
 		voteLastValid,
 		voteKeyDilution,
 		"",
-	), m.Width, true)
+	}
+	if notice := m.signNotice(); notice != "" {
+		lines = append(lines, notice, "")
+	}
+	return ansi.Hardwrap(lipgloss.JoinVertical(lipgloss.Left, lines...), m.Width, true)
+
+}
 
+// signNotice follows the key registration of the dev signer
+func (m ViewModel) signNotice() string {
+	switch {
+	case m.Signing:
+		return style.Yellow.Render("Signing with the dev signer, waiting for the confirmation...")
+	case m.Submitted == nil || m.Submitted.Address != m.Participation.Address:
+		return ""
+	case m.Submitted.Err != nil:
+		return style.Red.Render("Signing failed: " + m.Submitted.Err.Error())
+	default:
+		confirmed := style.Green.Render(fmt.Sprintf("Transaction %s confirmed in round %d", m.Submitted.TxId, m.Submitted.Round))
+		if link := m.State.Network().ExplorerLink(m.Submitted.TxId); link != "" {
+			return lipgloss.JoinVertical(lipgloss.Center, confirmed, style.WithHyperlink(link, link))
+		}
+		return confirmed
+	}
 }
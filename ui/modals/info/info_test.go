@@ -2,12 +2,17 @@ package info
 
 import (
 	"bytes"
+	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	client "github.com/algorandfoundation/algorun-tui/internal/test"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
 	"github.com/algorandfoundation/algorun-tui/ui/internal/test"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/exp/golden"
 	"github.com/charmbracelet/x/exp/teatest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -44,6 +49,51 @@ func Test_Snapshot(t *testing.T) {
 	})
 }
 
+func Test_Sign(t *testing.T) {
+	sk, _ := mnemonic.ToPrivateKey("artefact exist coil life turtle edge edge inside punch glance recycle teach melody diet method pause slam dumb race interest amused side learn able heavy")
+	key := mock.Keys[0]
+	key.Address = "TUIDKH2C7MUHZDD77MAMUREJRKNK25SYXB7OAFA6JFBB24PEL5UX4S4GUU"
+	m := New(test.GetState(client.GetClient(false)))
+	m.Participation = &key
+	m, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if cmd != nil || m.Signing {
+		t.Fatal("expected no dev signer")
+	}
+
+	m.State.Signer = internal.NewDevSigner(sk)
+	m.State.Status.Network = "mainnet-v1.0"
+	if m.CanSign() {
+		t.Error("expected the dev signer to be disabled on a public network")
+	}
+	m.State.Status.Network = "tuinet-v1.0"
+	m.UpdateState()
+	if !strings.Contains(ansi.Strip(m.Controls), "(s)ign") {
+		t.Errorf("expected the sign control, got %s", ansi.Strip(m.Controls))
+	}
+
+	// The fee policy applies to the signed registration
+	m.State.FeePolicy = internal.AlwaysFee
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	msg, ok := cmd().(app.KeyregSubmitted)
+	if !ok || msg.Err == nil || !strings.Contains(msg.Err.Error(), "incentive eligibility fee") {
+		t.Fatalf("expected the account to be unable to pay the fee, got %+v", msg)
+	}
+	m.State.FeePolicy = internal.NeverFee
+
+	m, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if cmd == nil || !m.Signing {
+		t.Fatal("expected the key registration to be signed")
+	}
+	msg, ok = cmd().(app.KeyregSubmitted)
+	if !ok || msg.Err != nil || msg.Address != key.Address || msg.Round != 10 {
+		t.Fatalf("expected the transaction to be confirmed, got %+v", msg)
+	}
+	m, _ = m.HandleMessage(msg)
+	if m.Signing || !strings.Contains(ansi.Strip(m.View()), "Transaction TXID confirmed in round 10") {
+		t.Errorf("expected the confirmation, got %s", ansi.Strip(m.View()))
+	}
+}
+
 func Test_Messages(t *testing.T) {
 	// Create the Model
 	m := New(test.GetState(nil))
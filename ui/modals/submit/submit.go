@@ -0,0 +1,108 @@
+package submit
+
+import (
+	"path/filepath"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PickerHeight is the number of files listed at once
+const PickerHeight = 10
+
+type ViewModel struct {
+	Width       int
+	Height      int
+	Title       string
+	Controls    string
+	BorderColor string
+	State       *internal.StateModel
+
+	// Picker lists the files of the working directory
+	Picker filepicker.Model
+	// Path is the file being submitted, empty while picking
+	Path string
+	// Participation is the key of the transaction modal, the file must register it or,
+	// when the key is Active, take its account offline
+	Participation *api.ParticipationKey
+	Active        bool
+}
+
+func New(state *internal.StateModel) *ViewModel {
+	picker := filepicker.New()
+	picker.AutoHeight = false
+	picker.Height = PickerHeight
+	picker.ShowPermissions = false
+	// esc closes the modal instead of leaving the directory
+	picker.KeyMap.Back = key.NewBinding(key.WithKeys("h", "backspace"))
+	return &ViewModel{
+		Width:       0,
+		Height:      0,
+		Title:       "Submit Transaction",
+		BorderColor: "2",
+		Controls:    "( enter | " + style.Red.Render("esc") + " )",
+		State:       state,
+		Picker:      picker,
+	}
+}
+
+// Init reads the directory of the Picker
+func (m ViewModel) Init() tea.Cmd {
+	return m.Picker.Init()
+}
+
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "esc" {
+			m.Path = ""
+			return &m, app.EmitModalEvent(app.ModalEvent{
+				Type: app.CancelModal,
+			})
+		}
+		// Wait for the submitted file before picking another one
+		if m.Path != "" {
+			return &m, nil
+		}
+	case app.KeyregSubmitted:
+		m.Path = ""
+		return &m, nil
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+		return &m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Picker, cmd = m.Picker.Update(msg)
+	if ok, path := m.Picker.DidSelectFile(msg); ok && m.State != nil && m.State.Client != nil && m.Participation != nil {
+		m.Path = path
+		return &m, app.EmitSubmitKeyreg(m.State.Context, m.State.Client, path, *m.Participation, m.Active)
+	}
+	return &m, cmd
+}
+
+func (m ViewModel) View() string {
+	if m.Path != "" {
+		return lipgloss.NewStyle().Padding(1).Render(lipgloss.JoinVertical(lipgloss.Center,
+			"Submitting "+filepath.Base(m.Path),
+			"",
+			style.Yellow.Render("Waiting for the transaction to be confirmed..."),
+		))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		"Select a key registration signed with goal clerk sign:",
+		"",
+		m.Picker.View(),
+	)
+}
@@ -0,0 +1,67 @@
+package submit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	uitest "github.com/algorandfoundation/algorun-tui/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+// listDir creates a model on a directory holding a signed transaction
+func listDir(t *testing.T) *ViewModel {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "keyreg.stxn"), []byte("signed"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := New(uitest.GetState(test.GetClient(false)))
+	m.Participation = &mock.Keys[0]
+	m.Picker.CurrentDirectory = dir
+	m, _ = m.HandleMessage(m.Init()())
+	m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 40})
+	return m
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Picker", func(t *testing.T) {
+		m := listDir(t)
+		got := ansi.Strip(m.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("Submitting", func(t *testing.T) {
+		m := listDir(t)
+		m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+		got := ansi.Strip(m.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+}
+
+func Test_Messages(t *testing.T) {
+	m := listDir(t)
+	m, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || m.Path == "" {
+		t.Fatal("expected the file to be submitted")
+	}
+	// The file is not a transaction
+	msg, ok := cmd().(app.KeyregSubmitted)
+	if !ok || msg.Err == nil {
+		t.Errorf("expected the submit to fail, got %+v", msg)
+	}
+	m, _ = m.HandleMessage(msg)
+	if m.Path != "" {
+		t.Errorf("expected the picker to be shown again")
+	}
+
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEsc})
+	evt, ok := cmd().(app.ModalEvent)
+	if !ok || evt.Type != app.CancelModal {
+		t.Errorf("expected esc to cancel the modal, got %+v", evt)
+	}
+}
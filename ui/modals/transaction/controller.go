@@ -34,7 +34,17 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 			return &m, app.EmitModalEvent(app.ModalEvent{
 				Type: app.CancelModal,
 			})
+		case "e":
+			if m.Participation != nil && m.State != nil && m.State.Client != nil {
+				return &m, app.EmitExportKeyreg(m.State, *m.Participation, m.Active, "")
+			}
+		case "s":
+			if m.Participation != nil && m.State != nil && m.State.Client != nil {
+				return &m, app.EmitShowModal(app.SubmitModal)
+			}
 		}
+	case app.KeyregExported:
+		m.Export = &msg
 	// Handle View Size changes
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
@@ -64,11 +74,11 @@ func (m *ViewModel) UpdateState() {
 	}
 
 	var fee *uint64
-	// TODO: enable fee with either feature flag or config flag
-	//if m.Account().IncentiveEligible && !m.Active {
-	//feeInst := uint64(2000000)
-	//fee = &feeInst
-	//}
+	if !m.Active && m.State != nil {
+		if keyregFee := m.State.KeyregFee(m.Participation.Address); keyregFee > 0 {
+			fee = &keyregFee
+		}
+	}
 
 	m.ATxn.AUrlTxnKeyCommon.Sender = m.Participation.Address
 	m.ATxn.AUrlTxnKeyCommon.Type = string(types.KeyRegistrationTx)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/algorandfoundation/algorun-tui/api"
 	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
 	"github.com/algorandfoundation/algorun-tui/ui/style"
 	"github.com/algorandfoundation/algourl/encoder"
 )
@@ -32,6 +33,11 @@ type ViewModel struct {
 
 	// QR Code
 	ATxn *encoder.AUrlTxn
+
+	// Export is the last unsigned transaction file written with (e)xport
+	Export *app.KeyregExported
+	// Submitted is the last signed transaction confirmed with (s)ubmit
+	Submitted *app.KeyregSubmitted
 }
 
 func (m ViewModel) FormatedAddress() string {
@@ -46,7 +52,7 @@ func New(state *internal.StateModel) *ViewModel {
 		IsOnline:    false,
 		BorderColor: "9",
 		navigation:  "| accounts | keys | " + style.Green.Render("txn") + " |",
-		Controls:    "( " + style.Red.Render("esc") + " )",
+		Controls:    "( (e)xport | (s)ubmit | " + style.Red.Render("esc") + " )",
 		ATxn:        nil,
 	}
 }
@@ -3,7 +3,9 @@ package transaction
 import (
 	"bytes"
 	"github.com/algorandfoundation/algorun-tui/internal"
+	client "github.com/algorandfoundation/algorun-tui/internal/test"
 	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
 	"github.com/algorandfoundation/algorun-tui/ui/internal/test"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/ansi"
@@ -79,6 +81,71 @@ func Test_Snapshot(t *testing.T) {
 		got := ansi.Strip(model.View())
 		golden.RequireEqual(t, []byte(got))
 	})
+	t.Run("Rekeyed", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Link = &internal.ShortLinkResponse{
+			Id: "1234",
+		}
+		model.Participation = &mock.Keys[0]
+		account := model.State.Accounts[model.Participation.Address]
+		account.AuthAddr = "JPEGRZ6G4IBZCOC7UV6QZWJ6TENNKRIPENUJTLG5K7PKIKMVTJHUGERARE"
+		model.State.Accounts[model.Participation.Address] = account
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{
+			Height: 40,
+			Width:  80,
+		})
+		model.UpdateState()
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("Exported", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Link = &internal.ShortLinkResponse{
+			Id: "1234",
+		}
+		model.Participation = &mock.Keys[0]
+		model.Export = &app.KeyregExported{Id: model.Participation.Id, Path: "123-online.txn"}
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{
+			Height: 40,
+			Width:  80,
+		})
+		model.UpdateState()
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("IncentiveFee", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Link = &internal.ShortLinkResponse{
+			Id: "1234",
+		}
+		model.Participation = &mock.Keys[0]
+		model.State.FeePolicy = internal.AlwaysFee
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{
+			Height: 40,
+			Width:  80,
+		})
+		model.UpdateState()
+		if model.ATxn.AUrlTxnKeyCommon.Fee == nil || *model.ATxn.AUrlTxnKeyCommon.Fee != internal.IncentiveFee {
+			t.Errorf("expected the incentive fee, got %v", model.ATxn.AUrlTxnKeyCommon.Fee)
+		}
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("Submitted", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Link = &internal.ShortLinkResponse{
+			Id: "1234",
+		}
+		model.Participation = &mock.Keys[0]
+		model.Submitted = &app.KeyregSubmitted{Address: model.Participation.Address, TxId: "TXID", Round: 10}
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{
+			Height: 40,
+			Width:  80,
+		})
+		model.UpdateState()
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
 	t.Run("Loading", func(t *testing.T) {
 		model := New(test.GetState(nil))
 		model.Participation = &mock.Keys[0]
@@ -92,6 +159,24 @@ func Test_Snapshot(t *testing.T) {
 	})
 }
 
+func Test_Export(t *testing.T) {
+	model := New(test.GetState(nil))
+	model.Participation = &mock.Keys[0]
+	model, cmd := model.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if cmd != nil {
+		t.Error("expected no export without a client")
+	}
+	model.State = test.GetState(client.GetClient(false))
+	model, cmd = model.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if cmd == nil {
+		t.Error("expected the export command")
+	}
+	model, _ = model.HandleMessage(app.KeyregExported{Id: mock.Keys[0].Id, Path: "123-online.txn"})
+	if model.Export == nil || model.Export.Path != "123-online.txn" {
+		t.Errorf("expected the exported file, got %+v", model.Export)
+	}
+}
+
 func Test_Messages(t *testing.T) {
 	// Create the Model
 	m := New(test.GetState(nil))
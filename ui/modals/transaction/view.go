@@ -29,7 +29,19 @@ func (m ViewModel) View() string {
 		adj = "online"
 	}
 	intro := fmt.Sprintf("Sign this transaction to register your account as %s", adj)
-	link := internal.ToShortLink(*m.Link)
+	if fee := m.feeNotice(); fee != "" {
+		intro = lipgloss.JoinVertical(lipgloss.Center, intro, "", fee)
+	}
+	if notice := m.signerNotice(); notice != "" {
+		intro = lipgloss.JoinVertical(lipgloss.Center, intro, "", notice)
+	}
+	if export := m.exportNotice(); export != "" {
+		intro = lipgloss.JoinVertical(lipgloss.Center, intro, "", export)
+	}
+	if submitted := m.submitNotice(); submitted != "" {
+		intro = lipgloss.JoinVertical(lipgloss.Center, intro, "", submitted)
+	}
+	link := internal.ToShortLink(m.State.ShortLinkURL, *m.Link)
 	loraText := lipgloss.JoinVertical(
 		lipgloss.Center,
 		"Open this URL in your browser:\n",
@@ -46,13 +58,14 @@ func (m ViewModel) View() string {
 	}
 
 	var render string
-	if m.State.Status.Network == "testnet-v1.0" || m.State.Status.Network == "mainnet-v1.0" {
+	network := m.State.Network()
+	if network.QR {
 		render = lipgloss.JoinVertical(
 			lipgloss.Center,
 			intro,
 			"",
 			"Scan the QR code with Pera or Defly",
-			style.Yellow.Render("(make sure you use the "+m.State.Status.Network+" network)"),
+			style.Yellow.Render("(make sure you use the "+network.Name+" network)"),
 			"",
 			qrStyle.Render(txn),
 			"-or-",
@@ -88,3 +101,79 @@ func (m ViewModel) View() string {
 
 	return render
 }
+
+// signerNotice warns when the wallet of the account can't sign the transaction by itself
+func (m ViewModel) signerNotice() string {
+	account := m.Account()
+	if account == nil {
+		return ""
+	}
+	// algod doesn't tell whether an account is a multisig account, the command is only spelled out for rekeyed accounts
+	if account.AuthAddr == "" {
+		return style.Yellow.Render("Multisig accounts: algorun keyreg multisig export --help")
+	}
+	lines := []string{
+		"This account is rekeyed, the transaction must be signed by:",
+		account.AuthAddr,
+		"When it is a multisig account, sign a partial transaction with:",
+	}
+	lines = append(lines, m.multisigExport()...)
+	lines = append(lines, "Fill in the threshold and the signers of the multisig, in order")
+	for i, line := range lines {
+		lines[i] = style.Yellow.Render(line)
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
+// multisigExport is the keyreg multisig export command of the transaction, one shell line per argument group
+func (m ViewModel) multisigExport() []string {
+	target := m.Participation.Id + " \\"
+	if m.Active {
+		target = "--offline --address " + m.Participation.Address + " \\"
+	}
+	return []string{
+		"algorun keyreg multisig export \\",
+		target,
+		"--threshold 2 --signer SIGNER1 --signer SIGNER2",
+	}
+}
+
+// exportNotice reports the file written for the transaction on screen
+func (m ViewModel) exportNotice() string {
+	if m.Export == nil || m.Export.Id != m.Participation.Id || m.Export.Offline != m.Active {
+		return ""
+	}
+	if m.Export.Err != nil {
+		return style.Red.Render(ansi.Wordwrap("Export failed: "+m.Export.Err.Error(), m.Width, " "))
+	}
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		style.Green.Render("Unsigned transaction saved to "+m.Export.Path),
+		"Sign it with goal clerk sign or a hardware wallet",
+	)
+}
+
+// submitNotice reports the confirmed transaction while the account catches up with the key
+func (m ViewModel) submitNotice() string {
+	if m.Submitted == nil || m.Submitted.Address != m.Participation.Address {
+		return ""
+	}
+	lines := []string{style.Green.Render(fmt.Sprintf("Transaction %s confirmed in round %d", m.Submitted.TxId, m.Submitted.Round))}
+	if link := m.State.Network().ExplorerLink(m.Submitted.TxId); link != "" {
+		lines = append(lines, style.WithHyperlink(link, link))
+	}
+	lines = append(lines, "Waiting for the account to use the key...")
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
+// feeNotice explains the incentive eligibility fee of the transaction
+func (m ViewModel) feeNotice() string {
+	if m.ATxn == nil || m.ATxn.AUrlTxnKeyCommon.Fee == nil {
+		return ""
+	}
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		style.Yellow.Render(fmt.Sprintf("This transaction pays a %s ALGO fee", internal.FormatAlgos(int(*m.ATxn.AUrlTxnKeyCommon.Fee)))),
+		style.Yellow.Render("to make the account eligible for staking rewards"),
+	)
+}
@@ -0,0 +1,87 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/api"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test/mock"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	"github.com/algorandfoundation/algorun-tui/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func detailedState() *internal.StateModel {
+	state := test.GetState(nil)
+	account := state.Accounts["ABC"]
+	account.Status = "Online"
+	account.Amount = 12_345_678
+	account.AmountWithoutPendingRewards = 12_345_000
+	account.PendingRewards = 678
+	account.MinBalance = 300_000
+	account.AuthAddr = "DEF"
+	account.Assets, account.CreatedAssets = 2, 1
+	account.Apps, account.CreatedApps = 3, 4
+	account.LastProposed, account.LastHeartbeat = 1200, 1300
+	account.Participation = &api.AccountParticipation{
+		SelectionParticipationKey: mock.Keys[0].Key.SelectionParticipationKey,
+		VoteParticipationKey:      mock.Keys[0].Key.VoteParticipationKey,
+		VoteLastValid:             mock.Keys[0].Key.VoteLastValid,
+		VoteKeyDilution:           mock.Keys[0].Key.VoteKeyDilution,
+	}
+	account.ProposalEstimate = &internal.ProposalEstimate{Share: 0.01, BlocksPerDay: 345.6}
+	account.Absence = &internal.AbsenceCheck{Risk: internal.AtRisk, Idle: 1100, Allowed: 2000, Reason: "no heartbeat for 1100 of 2000 rounds"}
+	state.Accounts["ABC"] = account
+	state.Proposals = map[string]internal.Proposals{
+		"ABC": internal.Proposals{}.
+			Add(internal.Proposal{Round: 1100, Payout: 10_000_000, Time: time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC)}).
+			Add(internal.Proposal{Round: 1200, Payout: 10_500_000, Time: time.Date(2024, 11, 1, 13, 0, 0, 0, time.UTC)}),
+	}
+	return state
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Visible", func(t *testing.T) {
+		model := New(detailedState())
+		model, _ = model.HandleMessage(app.AccountSelected{Address: "ABC"})
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 40})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("NonResidentKey", func(t *testing.T) {
+		state := detailedState()
+		account := state.Accounts["ABC"]
+		account.Participation.VoteLastValid++
+		account.Absence = nil
+		state.Accounts["ABC"] = account
+		state.Proposals = nil
+		model := New(state)
+		model.Address = "ABC"
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 40})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("NoAccount", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 10})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+}
+
+func Test_Messages(t *testing.T) {
+	m := New(test.GetState(nil))
+	m, _ = m.HandleMessage(*detailedState())
+	if m.Data.Accounts["ABC"].Amount != 12_345_678 {
+		t.Errorf("expected the state to be updated, got %+v", m.Data.Accounts["ABC"])
+	}
+	for key, page := range map[string]app.Page{"esc": app.AccountsPage, "k": app.KeysPage} {
+		_, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		if cmd == nil || cmd() != page {
+			t.Errorf("expected %s to show the %s page", key, page)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package account
+
+import (
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	// When the State changes
+	case internal.StateModel:
+		m.Data = &msg
+	// When the Account is Selected
+	case app.AccountSelected:
+		m.Address = msg.Address
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return m, app.EmitShowPage(app.AccountsPage)
+		case "k":
+			return m, app.EmitShowPage(app.KeysPage)
+		}
+	case tea.WindowSizeMsg:
+		borderRender := style.Border.Render("")
+		borderWidth := lipgloss.Width(borderRender)
+		borderHeight := lipgloss.Height(borderRender)
+
+		m.Width = max(0, msg.Width-borderWidth)
+		m.Height = max(0, msg.Height-borderHeight)
+	}
+	return m, nil
+}
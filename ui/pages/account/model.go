@@ -0,0 +1,130 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/algorandfoundation/algorun-tui/ui/utils"
+)
+
+// recentProposals is how many proposals of the timeline are listed
+const recentProposals = 5
+
+// ViewModel shows the on-chain information of a single account
+type ViewModel struct {
+	Data *internal.StateModel
+	// Address is the account shown on the page
+	Address string
+
+	Title       string
+	Navigation  string
+	Controls    string
+	BorderColor string
+	Width       int
+	Height      int
+}
+
+// New creates the account page, the Address is set when an account is selected
+func New(state *internal.StateModel) ViewModel {
+	return ViewModel{
+		Title:       "Account",
+		Width:       0,
+		Height:      0,
+		BorderColor: "6",
+		Data:        state,
+		Controls:    "( (k)eys | esc )",
+		Navigation:  "| " + style.Green.Render("accounts") + " | keys | metrics |",
+	}
+}
+
+// algos writes microAlgos with every decimal
+func algos(microAlgos int) string {
+	return internal.FormatAlgos(microAlgos) + " ALGO"
+}
+
+// round writes a round, or never when it is 0
+func round(r int) string {
+	if r == 0 {
+		return "never"
+	}
+	return fmt.Sprintf("round %d", r)
+}
+
+func label(name string) string {
+	return style.Cyan.Render(name + ": ")
+}
+
+// details lists the lines of the page
+func (m ViewModel) details() []string {
+	account, ok := m.Data.Accounts[m.Address]
+	if !ok {
+		return []string{"", "No account selected"}
+	}
+
+	status := account.Status
+	if account.Absence != nil && account.Absence.Risk != internal.HealthyRisk {
+		status += style.Red.Render(fmt.Sprintf(" ⚠ %s, %s", account.Absence.Risk, account.Absence.Reason))
+	}
+	authAddr := "not rekeyed"
+	if account.AuthAddr != "" {
+		authAddr = account.AuthAddr
+	}
+	eligible := "no"
+	if account.IncentiveEligible {
+		eligible = "yes"
+	}
+	lines := []string{
+		"",
+		label("Address") + account.Address,
+		label("Status") + status,
+		label("Auth Address") + authAddr,
+		label("Incentive Eligible") + eligible,
+		"",
+		label("Balance") + algos(account.Amount),
+		label("Without Pending Rewards") + algos(account.AmountWithoutPendingRewards),
+		label("Pending Rewards") + algos(account.PendingRewards),
+		label("Minimum Balance") + algos(account.MinBalance),
+		label("Assets") + fmt.Sprintf("%d opted in, %d created", account.Assets, account.CreatedAssets),
+		label("Apps") + fmt.Sprintf("%d opted in, %d created", account.Apps, account.CreatedApps),
+		"",
+		label("Last Proposed") + round(account.LastProposed),
+		label("Last Heartbeat") + round(account.LastHeartbeat),
+	}
+
+	proposals := m.Data.Proposals[m.Address]
+	lines = append(lines, label("Blocks Proposed")+fmt.Sprintf("%d, earning %s", proposals.Count, algos(proposals.Payouts)))
+	if estimate := account.ProposalEstimate; estimate != nil {
+		expected := fmt.Sprintf("%.2f blocks per day with %.4f%% of the online stake", estimate.BlocksPerDay, estimate.Share*100)
+		if estimate.Unlikely() {
+			expected += style.Red.Render(fmt.Sprintf(" ⚠ %d rounds without a proposal", estimate.DrySpell))
+		}
+		lines = append(lines, label("Expected")+expected)
+	}
+	if len(proposals.Timeline) > 0 {
+		lines = append(lines, label("Recent Proposals"))
+	}
+	for i := len(proposals.Timeline) - 1; i >= max(0, len(proposals.Timeline)-recentProposals); i-- {
+		proposal := proposals.Timeline[i]
+		lines = append(lines, fmt.Sprintf("  round %d, %s, %s", proposal.Round, algos(proposal.Payout), proposal.Time.Format(time.RFC822)))
+	}
+
+	lines = append(lines, "")
+	participation := account.Participation
+	if participation == nil {
+		return append(lines, style.Yellow.Render("Registered Participation: ")+"none")
+	}
+	local := style.Red.Render("⚠ not on this node")
+	if key := internal.FindActiveKey(m.Data.ParticipationKeys, account); key != nil {
+		local = style.Green.Render("matches " + key.Id)
+	}
+	return append(lines,
+		style.Yellow.Render("Registered Participation: ")+local,
+		style.Yellow.Render("Selection Key: ")+*utils.UrlEncodeBytesPtrOrNil(participation.SelectionParticipationKey),
+		style.Yellow.Render("Vote Key: ")+*utils.UrlEncodeBytesPtrOrNil(participation.VoteParticipationKey),
+		style.Purple("Vote First Valid: ")+utils.IntToStr(participation.VoteFirstValid),
+		style.Purple("Vote Last Valid: ")+utils.IntToStr(participation.VoteLastValid),
+		style.Purple("Vote Key Dilution: ")+utils.IntToStr(participation.VoteKeyDilution),
+	)
+}
@@ -0,0 +1,30 @@
+package account
+
+import (
+	"strings"
+
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func (m ViewModel) View() string {
+	lines := m.details()
+	// Keep the top of the page when the terminal is too short
+	if len(lines) > m.Height {
+		lines = lines[:m.Height]
+	}
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(" "+line, m.Width, "…")
+	}
+	page := style.ApplyBorder(m.Width, m.Height, m.BorderColor).Render(strings.Join(lines, "\n"))
+	return style.WithNavigation(
+		m.Navigation,
+		style.WithControls(
+			m.Controls,
+			style.WithTitle(
+				m.Title,
+				page,
+			),
+		),
+	)
+}
@@ -41,6 +41,15 @@ func Test_New(t *testing.T) {
 		t.Errorf("expected true, got false")
 	}
 
+	// The details of the selected account are shown with (i)nfo
+	_, cmd = m.HandleMessage(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("i"),
+	})
+	if cmd == nil {
+		t.Errorf("expected a command to show the account page")
+	}
+
 	// Update syncing state
 	m.Data.Status.State = internal.SyncingState
 	m.makeRows()
@@ -94,3 +103,38 @@ func Test_Messages(t *testing.T) {
 
 	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
 }
+
+func Test_Expected(t *testing.T) {
+	for _, tc := range []struct {
+		estimate *internal.ProposalEstimate
+		expected string
+	}{
+		{nil, "N/A"},
+		{&internal.ProposalEstimate{BlocksPerDay: 345.6}, "346/d"},
+		{&internal.ProposalEstimate{BlocksPerDay: 4.12}, "4.1/d"},
+		{&internal.ProposalEstimate{BlocksPerDay: 0.25}, "0.25/d"},
+		{&internal.ProposalEstimate{BlocksPerDay: 0.001}, "<0.01/d"},
+		{&internal.ProposalEstimate{BlocksPerDay: 4.12, DrySpell: 100, Probability: 0.5}, "4.1/d"},
+		{&internal.ProposalEstimate{BlocksPerDay: 4.12, DrySpell: 100000, Probability: 0.001}, "⚠ 4.1/d"},
+	} {
+		if got := expected(tc.estimate); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func Test_Status(t *testing.T) {
+	for _, tc := range []struct {
+		account  internal.Account
+		expected string
+	}{
+		{internal.Account{Status: "Offline"}, "Offline"},
+		{internal.Account{Status: "Online", Absence: &internal.AbsenceCheck{Risk: internal.HealthyRisk, Idle: 10, Allowed: 2000}}, "Online"},
+		{internal.Account{Status: "Online", Absence: &internal.AbsenceCheck{Risk: internal.AtRisk, Idle: 1100, Allowed: 2000}}, "⚠ AT-RISK 1100/2000 idle"},
+		{internal.Account{Status: "Online", Absence: &internal.AbsenceCheck{Risk: internal.LikelySuspendedRisk, Idle: 2100, Allowed: 2000}}, "⚠ LIKELY-SUSPENDED 2100/2000 idle"},
+	} {
+		if got := status(tc.account); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
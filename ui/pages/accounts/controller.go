@@ -32,6 +32,13 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 				return m, tea.Batch(cmds...)
 			}
 			return m, nil
+		// Show the on-chain information of the account
+		case "i":
+			selAcc := m.SelectedAccount()
+			if selAcc != nil {
+				return m, tea.Batch(app.EmitAccountSelected(*selAcc), app.EmitShowPage(app.AccountPage))
+			}
+			return m, nil
 		}
 	case tea.WindowSizeMsg:
 		borderRender := style.Border.Render("")
@@ -1,9 +1,11 @@
 package accounts
 
 import (
+	"fmt"
 	"github.com/algorandfoundation/algorun-tui/ui/style"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/algorandfoundation/algorun-tui/internal"
@@ -31,8 +33,8 @@ func New(state *internal.StateModel) ViewModel {
 		Height:      0,
 		BorderColor: "6",
 		Data:        state,
-		Controls:    "( (g)enerate )",
-		Navigation:  "| " + style.Green.Render("accounts") + " | keys |",
+		Controls:    "( (g)enerate | (i)nfo | (m)etrics | (d)ashboard )",
+		Navigation:  "| " + style.Green.Render("accounts") + " | keys | metrics |",
 	}
 
 	m.table = table.New(
@@ -64,16 +66,49 @@ func (m ViewModel) SelectedAccount() *internal.Account {
 	return account
 }
 func (m ViewModel) makeColumns(width int) []table.Column {
-	avgWidth := (width - lipgloss.Width(style.Border.Render("")) - 9) / 5
+	avgWidth := (width - lipgloss.Width(style.Border.Render("")) - 15) / 8
 	return []table.Column{
 		{Title: "Account", Width: avgWidth},
 		{Title: "Keys", Width: avgWidth},
 		{Title: "Status", Width: avgWidth},
 		{Title: "Expires", Width: avgWidth},
 		{Title: "Balance", Width: avgWidth},
+		{Title: "Blocks", Width: avgWidth},
+		{Title: "Rate", Width: avgWidth},
+		{Title: "Payouts", Width: avgWidth},
 	}
 }
 
+// expected renders the expected proposals per day, with a warning when the dry spell is unlikely
+func expected(estimate *internal.ProposalEstimate) string {
+	if estimate == nil {
+		return "N/A"
+	}
+	var rate string
+	switch {
+	case estimate.BlocksPerDay >= 10:
+		rate = fmt.Sprintf("%.0f/d", estimate.BlocksPerDay)
+	case estimate.BlocksPerDay >= 1:
+		rate = fmt.Sprintf("%.1f/d", estimate.BlocksPerDay)
+	case estimate.BlocksPerDay >= 0.01:
+		rate = fmt.Sprintf("%.2f/d", estimate.BlocksPerDay)
+	default:
+		rate = "<0.01/d"
+	}
+	if estimate.Unlikely() {
+		return "⚠ " + rate
+	}
+	return rate
+}
+
+// status renders the account status, replaced by the suspension risk when it is not healthy
+func status(account internal.Account) string {
+	if account.Absence == nil || account.Absence.Risk == internal.HealthyRisk {
+		return account.Status
+	}
+	return fmt.Sprintf("⚠ %s %d/%d idle", strings.ToUpper(string(account.Absence.Risk)), account.Absence.Idle, account.Absence.Allowed)
+}
+
 func (m ViewModel) makeRows() *[]table.Row {
 	rows := make([]table.Row, 0)
 
@@ -108,9 +143,12 @@ func (m ViewModel) makeRows() *[]table.Row {
 		rows = append(rows, table.Row{
 			m.Data.Accounts[addr].Address,
 			strconv.Itoa(m.Data.Accounts[addr].Keys),
-			m.Data.Accounts[addr].Status,
+			status(m.Data.Accounts[addr]),
 			expires,
 			strconv.Itoa(m.Data.Accounts[addr].Balance),
+			strconv.Itoa(m.Data.Proposals[addr].Count),
+			expected(m.Data.Accounts[addr].ProposalEstimate),
+			internal.FormatAlgos(m.Data.Proposals[addr].Payouts),
 		})
 	}
 	sort.SliceStable(rows, func(i, j int) bool {
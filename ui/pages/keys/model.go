@@ -53,7 +53,7 @@ func New(address string, keys *[]api.ParticipationKey) ViewModel {
 		// Page Wrapper
 		Title:       "Keys",
 		Controls:    "( (g)enerate )",
-		Navigation:  "| accounts | " + style.Green.Render("keys") + " |",
+		Navigation:  "| accounts | " + style.Green.Render("keys") + " | metrics |",
 		BorderColor: "4",
 	}
 
@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case internal.StateModel:
+		m.Data = &msg
+		m.table.SetRows(*m.makeRows())
+	case app.PinnedMetrics:
+		m.Pinned = msg
+		m.table.SetRows(*m.makeRows())
+	case tea.KeyMsg:
+		// The filter takes every key while it is focused
+		if m.filter.Focused() {
+			switch msg.String() {
+			case "esc":
+				m.filter.SetValue("")
+				m.filter.Blur()
+			case "enter":
+				m.filter.Blur()
+			default:
+				m.filter, _ = m.filter.Update(msg)
+			}
+			m.table.SetRows(*m.makeRows())
+			m.table.GotoTop()
+			return m, nil
+		}
+		switch msg.String() {
+		case "/":
+			m.filter.Focus()
+			return m, nil
+		case "p":
+			key := m.SelectedSeries()
+			if key != "" {
+				return m, app.EmitPinnedMetrics(m.togglePin(key))
+			}
+			return m, nil
+		case "esc":
+			if m.filter.Value() != "" {
+				m.filter.SetValue("")
+				m.table.SetRows(*m.makeRows())
+				return m, nil
+			}
+			return m, app.EmitShowPage(app.AccountsPage)
+		}
+	case tea.WindowSizeMsg:
+		borderRender := style.Border.Render("")
+		borderWidth := lipgloss.Width(borderRender)
+		borderHeight := lipgloss.Height(borderRender)
+
+		m.Width = max(0, msg.Width-borderWidth)
+		m.Height = max(0, msg.Height-borderHeight)
+
+		// The filter uses the first line of the page
+		m.filter.Width = max(0, m.Width-lipgloss.Width(m.filter.Prompt)-1)
+		m.table.SetWidth(m.Width)
+		m.table.SetHeight(max(0, m.Height-1))
+		m.table.SetColumns(m.makeColumns(m.Width))
+	}
+
+	// Handle Table Update
+	m.table, _ = m.table.Update(msg)
+
+	return m, nil
+}
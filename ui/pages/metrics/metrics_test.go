@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"context"
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	uitest "github.com/algorandfoundation/algorun-tui/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"testing"
+)
+
+func getState(t *testing.T) *internal.StateModel {
+	state := uitest.GetState(nil)
+	families, err := internal.GetMetrics(context.Background(), test.GetClient(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.Metrics.Families = families
+	state.Metrics.Rates = map[string]float64{
+		"algod_crypto_vrf_hash_total": 1.5,
+	}
+	return state
+}
+
+func typeKeys(m ViewModel, keys string) ViewModel {
+	for _, r := range keys {
+		m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func Test_Filter(t *testing.T) {
+	m := New(getState(t))
+	m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 20})
+	if len(m.table.Rows()) != 6 {
+		t.Fatalf("expected every series, got %d rows", len(m.table.Rows()))
+	}
+
+	m = typeKeys(m, "/")
+	if !m.Filtering() {
+		t.Fatal("expected the filter to be focused")
+	}
+	m = typeKeys(m, "VRF")
+	if len(m.table.Rows()) != 3 {
+		t.Errorf("expected 3 vrf series, got %d", len(m.table.Rows()))
+	}
+	m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Filtering() || len(m.table.Rows()) != 3 {
+		t.Error("expected enter to keep the filter and release the keyboard")
+	}
+
+	// Escape clears the filter before leaving the page
+	m, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil || len(m.table.Rows()) != 6 {
+		t.Error("expected escape to clear the filter")
+	}
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil || cmd() != app.AccountsPage {
+		t.Error("expected escape to show the accounts page")
+	}
+}
+
+func Test_Pin(t *testing.T) {
+	m := New(getState(t))
+	m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 20})
+	selected := m.SelectedSeries()
+	if selected != "algod_crypto_vrf_generate_total" {
+		t.Fatalf("expected the first series to be selected, got %s", selected)
+	}
+
+	_, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	pinned, ok := cmd().(app.PinnedMetrics)
+	if !ok || len(pinned) != 1 || pinned[0] != selected {
+		t.Fatalf("expected %s to be pinned, got %v", selected, pinned)
+	}
+	m, _ = m.HandleMessage(pinned)
+	if m.table.Rows()[0][0] != "★" {
+		t.Error("expected the pinned row to be marked")
+	}
+
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if pinned := cmd().(app.PinnedMetrics); len(pinned) != 0 {
+		t.Errorf("expected the series to be unpinned, got %v", pinned)
+	}
+}
+
+func Test_FormatValue(t *testing.T) {
+	for value, expected := range map[float64]string{
+		0:          "0",
+		1234567890: "1234567890",
+		0.5:        "0.5000",
+		-2.25:      "-2.2500",
+	} {
+		if got := FormatValue(value); got != expected {
+			t.Errorf("expected %s, got %s", expected, got)
+		}
+	}
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Visible", func(t *testing.T) {
+		m := New(getState(t))
+		m, _ = m.HandleMessage(app.PinnedMetrics{"algod_ram_usage"})
+		m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 20})
+		golden.RequireEqual(t, []byte(ansi.Strip(m.View())))
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		m := New(getState(t))
+		m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 20})
+		m = typeKeys(m, "/telemetry")
+		golden.RequireEqual(t, []byte(ansi.Strip(m.View())))
+	})
+	t.Run("Loading", func(t *testing.T) {
+		m := New(uitest.GetState(nil))
+		m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 20})
+		golden.RequireEqual(t, []byte(ansi.Strip(m.View())))
+	})
+}
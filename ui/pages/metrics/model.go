@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ViewModel lists every series from the algod /metrics endpoint
+type ViewModel struct {
+	Data *internal.StateModel
+
+	// Pinned holds the series keys shown in the status header
+	Pinned []string
+
+	Title       string
+	Navigation  string
+	Controls    string
+	BorderColor string
+	Width       int
+	Height      int
+
+	filter textinput.Model
+	table  table.Model
+}
+
+// New creates the metrics page with an empty filter
+func New(state *internal.StateModel) ViewModel {
+	m := ViewModel{
+		Title:       "Metrics",
+		Width:       0,
+		Height:      0,
+		BorderColor: "3",
+		Data:        state,
+		Controls:    "( / filter | (p)in )",
+		Navigation:  "| accounts | keys | " + style.Green.Render("metrics") + " |",
+	}
+
+	m.filter = textinput.New()
+	m.filter.Prompt = " / "
+	m.filter.Placeholder = "filter"
+	m.filter.Cursor.SetMode(cursor.CursorStatic)
+
+	m.table = table.New(
+		table.WithColumns(m.makeColumns(0)),
+		table.WithRows(*m.makeRows()),
+		table.WithFocused(true),
+	)
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("229")).
+		Background(lipgloss.Color(m.BorderColor)).
+		Bold(false)
+	m.table.SetStyles(s)
+	return m
+}
+
+// Filtering is true while the filter has the keyboard
+func (m ViewModel) Filtering() bool {
+	return m.filter.Focused()
+}
+
+// SelectedSeries is the key of the highlighted row
+func (m ViewModel) SelectedSeries() string {
+	row := m.table.SelectedRow()
+	if row == nil {
+		return ""
+	}
+	return row[1]
+}
+
+// togglePin adds or removes the series from the pinned list
+func (m ViewModel) togglePin(key string) []string {
+	if i := slices.Index(m.Pinned, key); i >= 0 {
+		return slices.Delete(slices.Clone(m.Pinned), i, i+1)
+	}
+	return append(slices.Clone(m.Pinned), key)
+}
+
+func (m ViewModel) makeColumns(width int) []table.Column {
+	valueWidth := 16
+	rateWidth := 14
+	pinWidth := 3
+	keyWidth := max(0, width-valueWidth-rateWidth-pinWidth-lipgloss.Width(style.Border.Render(""))-6)
+	return []table.Column{
+		{Title: "", Width: pinWidth},
+		{Title: "Metric", Width: keyWidth},
+		{Title: "Value", Width: valueWidth},
+		{Title: "Rate/s", Width: rateWidth},
+	}
+}
+
+func (m ViewModel) makeRows() *[]table.Row {
+	rows := make([]table.Row, 0)
+	if m.Data == nil {
+		return &rows
+	}
+	filter := strings.ToLower(strings.TrimSpace(m.filter.Value()))
+	for _, sample := range m.Data.Metrics.Families.Series() {
+		key := sample.Key()
+		if filter != "" && !strings.Contains(strings.ToLower(key), filter) {
+			continue
+		}
+		pin := ""
+		if slices.Contains(m.Pinned, key) {
+			pin = "★"
+		}
+		rate := "--"
+		if value, ok := m.Data.Metrics.Rates[key]; ok {
+			rate = fmt.Sprintf("%.2f", value)
+		}
+		rows = append(rows, table.Row{
+			pin,
+			key,
+			FormatValue(sample.Value),
+			rate,
+		})
+	}
+	return &rows
+}
+
+// FormatValue prints integers without decimals and floats with up to 4 decimals
+func FormatValue(value float64) string {
+	if value == float64(int64(value)) {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"github.com/algorandfoundation/algorun-tui/ui/style"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) View() string {
+	content := lipgloss.JoinVertical(lipgloss.Left, m.filter.View(), m.table.View())
+	if m.Data == nil || !m.Data.Metrics.Enabled || m.Data.Metrics.Families == nil {
+		content = lipgloss.JoinVertical(lipgloss.Left, m.filter.View(), " Waiting for /metrics from the node...")
+	}
+	page := style.ApplyBorder(m.Width, m.Height, m.BorderColor).Render(content)
+	return style.WithNavigation(
+		m.Navigation,
+		style.WithControls(
+			m.Controls,
+			style.WithTitle(
+				m.Title,
+				page,
+			),
+		),
+	)
+}
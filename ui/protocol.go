@@ -1,17 +1,23 @@
 package ui
 
 import (
+	"fmt"
 	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/ui/style"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"path"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // ProtocolViewModel includes the internal.StatusModel and internal.MetricsModel
 type ProtocolViewModel struct {
-	Data           internal.StatusModel
+	Data internal.StatusModel
+	// RoundTime estimates the time left in the upgrade vote and until the upgrade
+	RoundTime      time.Duration
 	TerminalWidth  int
 	TerminalHeight int
 	IsVisible      bool
@@ -35,6 +41,11 @@ func (m ProtocolViewModel) HandleMessage(msg tea.Msg) (ProtocolViewModel, tea.Cm
 	case internal.StatusModel:
 		m.Data = msg
 		return m, nil
+	// Handle a State Update from the watcher
+	case internal.StateModel:
+		m.Data = msg.Status
+		m.RoundTime = msg.Metrics.RoundTime
+		return m, nil
 	// Update Viewport Size
 	case tea.WindowSizeMsg:
 		m.TerminalWidth = msg.Width
@@ -75,14 +86,15 @@ func (m ProtocolViewModel) View() string {
 
 	middle := strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
 
+	upgrade := m.upgradeRows(size - 2)
 	var rows []string
 	// Last Round
 	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end))
-	if !isCompact {
+	if !isCompact && len(upgrade) == 0 {
 		rows = append(rows, "")
 	}
 	rows = append(rows, style.Blue.Render(" Network: ")+m.Data.Network)
-	if !isCompact {
+	if !isCompact && len(upgrade) == 0 {
 		rows = append(rows, "")
 	}
 	rows = append(rows, style.Blue.Render(" Protocol Voting: ")+strconv.FormatBool(m.Data.Voting))
@@ -90,15 +102,97 @@ func (m ProtocolViewModel) View() string {
 	if isCompact && m.Data.NeedsUpdate {
 		rows = append(rows, style.Blue.Render(" Upgrade Available: ")+style.Green.Render(strconv.FormatBool(m.Data.NeedsUpdate)))
 	}
-	return style.WithTitle("Protocol", style.ApplyBorder(max(0, size-2), 5, "5").Render(lipgloss.JoinVertical(lipgloss.Left,
+	// The compact panel only has room for the most important row
+	if isCompact && len(upgrade) > 0 {
+		upgrade = upgrade[:1]
+	}
+	rows = append(rows, upgrade...)
+
+	color := "5"
+	if m.Data.Upgrade.Unsupported() {
+		color = "9"
+	}
+	return style.WithTitle("Protocol", style.ApplyBorder(max(0, size-2), 5, color).Render(lipgloss.JoinVertical(lipgloss.Left,
 		rows...,
 	)))
 }
 
+// upgradeRows describes the protocol upgrade in two rows of width, none without an upgrade.
+// An unsupported upgrade comes first, then the vote and then the approved upgrade.
+func (m ProtocolViewModel) upgradeRows(width int) []string {
+	upgrade := m.Data.Upgrade
+	lastRound := m.Data.LastRound
+	var rows []string
+	switch {
+	case upgrade.StoppedAtUnsupportedRound:
+		rows = []string{
+			style.Red.Bold(true).Render(" STOPPED AT AN UNSUPPORTED PROTOCOL UPGRADE"),
+			style.Red.Render(" Update algod to follow the network"),
+		}
+	case upgrade.Unsupported():
+		rows = []string{
+			style.Red.Bold(true).Render(fmt.Sprintf(" UNSUPPORTED PROTOCOL UPGRADE AT ROUND %d", upgrade.NextVersionRound)),
+			style.Red.Render(" Update algod within " + m.untilRound(upgrade.NextVersionRound)),
+		}
+	case upgrade.Voting(lastRound):
+		barWidth := max(5, min(20, width-30))
+		rows = []string{
+			style.Blue.Render(" Upgrade Vote: ") + progressBar(barWidth, upgrade.YesVotes, upgrade.VotesRequired) +
+				fmt.Sprintf(" %d yes %d no / %d", upgrade.YesVotes, upgrade.NoVotes, upgrade.VotesRequired),
+			style.Blue.Render(" Vote Ends: ") + m.untilRound(upgrade.VoteBefore) +
+				style.Blue.Render(" Applies: ") + m.untilRound(upgrade.VoteBefore+upgrade.Delay),
+		}
+	case upgrade.Scheduled(lastRound):
+		rows = []string{
+			style.Blue.Render(" Upgrade: ") + style.Green.Render("approved ") + shortProtocol(upgrade.NextVersion),
+			style.Blue.Render(" Applies: ") + m.untilRound(upgrade.NextVersionRound),
+		}
+	}
+	for i, row := range rows {
+		rows[i] = ansi.Truncate(row, width, "…")
+	}
+	return rows
+}
+
+// untilRound is the number of rounds and the estimated time to a round
+func (m ProtocolViewModel) untilRound(round int) string {
+	rounds := internal.RoundsUntil(round, m.Data.LastRound)
+	text := fmt.Sprintf("%d rounds", rounds)
+	if eta, ok := internal.TimeUntil(round, m.Data.LastRound, m.RoundTime); ok {
+		text += " (~" + formatETA(eta) + ")"
+	}
+	return text
+}
+
+// formatETA keeps the two largest units of a duration
+func formatETA(d time.Duration) string {
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// shortProtocol is the commit of a protocol from the specs repository, or the protocol itself
+func shortProtocol(protocol string) string {
+	short := path.Base(protocol)
+	if len(short) > 8 {
+		return short[:8]
+	}
+	return short
+}
+
 // MakeProtocolViewModel constructs a ProtocolViewModel using a given StatusModel and predefined metrics.
 func MakeProtocolViewModel(state *internal.StateModel) ProtocolViewModel {
 	return ProtocolViewModel{
 		Data:           state.Status,
+		RoundTime:      state.Metrics.RoundTime,
 		TerminalWidth:  0,
 		TerminalHeight: 0,
 		IsVisible:      true,
@@ -90,6 +90,106 @@ var protocolViewSnapshots = map[string]ProtocolViewModel{
 		TerminalHeight: 40,
 		IsVisible:      true,
 	},
+	"UpgradeVote": {
+		Data: internal.StatusModel{
+			State:     "RUNNING",
+			Version:   "v0.0.0-test",
+			Network:   "test-v1",
+			Voting:    true,
+			LastRound: 100000,
+			Upgrade: internal.UpgradeModel{
+				LastVersion:          "future",
+				NextVersion:          "future",
+				NextVersionRound:     100001,
+				NextVersionSupported: true,
+				VoteBefore:           101200,
+				VoteRounds:           10000,
+				Votes:                8800,
+				YesVotes:             5600,
+				NoVotes:              200,
+				VotesRequired:        9000,
+				Delay:                140000,
+			},
+		},
+		RoundTime:      time.Millisecond * 2800,
+		TerminalWidth:  160,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"UpgradeVoteSmall": {
+		Data: internal.StatusModel{
+			State:     "RUNNING",
+			Version:   "v0.0.0-test",
+			Network:   "test-v1",
+			Voting:    true,
+			LastRound: 100000,
+			Upgrade: internal.UpgradeModel{
+				VoteBefore:    101200,
+				YesVotes:      5600,
+				NoVotes:       200,
+				VotesRequired: 9000,
+				Delay:         140000,
+			},
+		},
+		TerminalWidth:  80,
+		TerminalHeight: 40,
+		IsVisible:      true,
+	},
+	"UpgradeScheduled": {
+		Data: internal.StatusModel{
+			State:     "RUNNING",
+			Version:   "v0.0.0-test",
+			Network:   "test-v1",
+			Voting:    true,
+			LastRound: 100000,
+			Upgrade: internal.UpgradeModel{
+				LastVersion:          "https://github.com/algorandfoundation/specs/tree/925a46433742afb0b51bb939354bd907fa88bf95",
+				NextVersion:          "https://github.com/algorandfoundation/specs/tree/236dcc18c9c507d794813ab768e467ea42d1b4d9",
+				NextVersionRound:     240000,
+				NextVersionSupported: true,
+			},
+		},
+		RoundTime:      time.Millisecond * 2800,
+		TerminalWidth:  160,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"UpgradeUnsupported": {
+		Data: internal.StatusModel{
+			State:       "RUNNING",
+			Version:     "v0.0.0-test",
+			Network:     "test-v1",
+			NeedsUpdate: true,
+			LastRound:   100000,
+			Upgrade: internal.UpgradeModel{
+				LastVersion:      "future",
+				NextVersion:      "https://github.com/algorandfoundation/specs/tree/236dcc18c9c507d794813ab768e467ea42d1b4d9",
+				NextVersionRound: 100500,
+			},
+		},
+		RoundTime:      time.Millisecond * 2800,
+		TerminalWidth:  160,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"StoppedAtUnsupportedRound": {
+		Data: internal.StatusModel{
+			State:       "RUNNING",
+			Version:     "v0.0.0-test",
+			Network:     "test-v1",
+			NeedsUpdate: true,
+			LastRound:   100500,
+			Upgrade: internal.UpgradeModel{
+				LastVersion:               "future",
+				NextVersion:               "future",
+				NextVersionRound:          100501,
+				StoppedAtUnsupportedRound: true,
+			},
+		},
+		TerminalWidth:  80,
+		TerminalHeight: 40,
+		IsVisible:      true,
+	},
 }
 
 func Test_ProtocolSnapshot(t *testing.T) {
@@ -142,6 +242,26 @@ func Test_ProtocolMessages(t *testing.T) {
 		NeedsUpdate: false,
 		LastRound:   0,
 	})
+	// The watcher updates the upgrade with the state
+	tm.Send(internal.StateModel{
+		Status: internal.StatusModel{
+			LastRound: 1337,
+			Upgrade: internal.UpgradeModel{
+				LastVersion:      "future",
+				NextVersion:      "next",
+				NextVersionRound: 2000,
+			},
+		},
+		Metrics: internal.MetricsModel{RoundTime: time.Second * 3},
+	})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("UNSUPPORTED PROTOCOL UPGRADE"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
 	// Send hide key
 	tm.Send(tea.KeyMsg{
 		Type:  tea.KeyRunes,
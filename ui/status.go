@@ -3,9 +3,12 @@ package ui
 import (
 	"fmt"
 	"github.com/algorandfoundation/algorun-tui/internal"
+	"github.com/algorandfoundation/algorun-tui/ui/app"
+	"github.com/algorandfoundation/algorun-tui/ui/pages/metrics"
 	"github.com/algorandfoundation/algorun-tui/ui/style"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"math"
 	"strconv"
 	"strings"
@@ -18,6 +21,33 @@ type StatusViewModel struct {
 	TerminalWidth  int
 	TerminalHeight int
 	IsVisible      bool
+	// Pinned are the metric series shown in place of the spacer
+	Pinned []string
+	// Notification replaces the pinned metrics for a while after a block proposal or a risk change
+	Notification string
+	// Alert renders the Notification as a warning
+	Alert bool
+
+	notified int
+}
+
+// notificationDuration is how long a Notification is shown
+const notificationDuration = time.Second * 10
+
+// clearNotification hides a notification once it expires
+type clearNotification struct {
+	ID int
+}
+
+// notify shows the text until it expires or is replaced
+func (m StatusViewModel) notify(text string, alert bool) (StatusViewModel, tea.Cmd) {
+	m.Notification = text
+	m.Alert = alert
+	m.notified++
+	id := m.notified
+	return m, tea.Tick(notificationDuration, func(time.Time) tea.Msg {
+		return clearNotification{ID: id}
+	})
 }
 
 // Init has no I/O right now
@@ -36,6 +66,34 @@ func (m StatusViewModel) HandleMessage(msg tea.Msg) (StatusViewModel, tea.Cmd) {
 	// Is it a heartbeat of the latest round?
 	case internal.StateModel:
 		m.Data = &msg
+	// Are the favourite metrics changing?
+	case app.PinnedMetrics:
+		m.Pinned = msg
+	// Did one of our accounts propose a block?
+	case internal.BlockProposed:
+		return m.notify(fmt.Sprintf("★ %s proposed round %d, earning %s ALGO", shortAddress(msg.Address), msg.Round, internal.FormatAlgos(msg.Payout)), false)
+	// Is one of our accounts about to be suspended?
+	case internal.AbsenceRiskChanged:
+		if msg.Risk == internal.HealthyRisk {
+			return m.notify(fmt.Sprintf("✔ %s is healthy again, %s", shortAddress(msg.Address), msg.Reason), false)
+		}
+		return m.notify(fmt.Sprintf("⚠ %s is %s, %s", shortAddress(msg.Address), msg.Risk, msg.Reason), true)
+	// Did the node stop producing rounds?
+	case internal.NodeStalled:
+		return m.notify(fmt.Sprintf("⚠ The node is stalled at round %d, no round for %s", msg.Round, msg.Since.Round(time.Second)), true)
+	case internal.NodeResumed:
+		return m.notify(fmt.Sprintf("✔ The node resumed at round %d", msg.Round), false)
+	// Is the local clock drifting from the network?
+	case internal.ClockSkewChanged:
+		if !msg.Skewed {
+			return m.notify("✔ The local clock is in sync with the network again", false)
+		}
+		return m.notify(fmt.Sprintf("⚠ The local clock is %s from the last block, check the time synchronization", internal.FormatSkew(msg.Skew)), true)
+	case clearNotification:
+		if msg.ID == m.notified {
+			m.Notification = ""
+			m.Alert = false
+		}
 	// Is it a resize event?
 	case tea.WindowSizeMsg:
 		m.TerminalWidth = msg.Width
@@ -82,12 +140,18 @@ func (m StatusViewModel) View() string {
 	beginning := style.Blue.Render(" Latest Round: ") + strconv.Itoa(int(m.Data.Status.LastRound))
 
 	var end string
-	switch m.Data.Status.State {
-	case internal.StableState:
+	switch {
+	case m.Data.Status.State == internal.StableState && m.Data.Status.Health.Stalled:
+		end = style.Red.Render("STALLED") + " "
+	case m.Data.Status.State == internal.StableState:
 		end = style.Green.Render(strings.ToUpper(string(m.Data.Status.State))) + " "
 	default:
 		end = style.Yellow.Render(strings.ToUpper(string(m.Data.Status.State))) + " "
 	}
+	// A skewed clock breaks the time estimates and the votes of the node
+	if m.Data.Status.Health.Skewed() {
+		end = style.Yellow.Render("⚠ CLOCK "+internal.FormatSkew(m.Data.Status.Health.ClockSkew)) + " " + end
+	}
 	middle := strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
 
 	// Last Round
@@ -98,8 +162,15 @@ func (m StatusViewModel) View() string {
 		roundTime = "--"
 	}
 	beginning = style.Blue.Render(" Round time: ") + roundTime
+	// A syncing node is compared with the reference node
+	syncing := m.Data.Status.State == internal.SyncingState
+	sync := m.Data.Status.Sync
+	if syncing && sync.NetworkRound > 0 {
+		beginning = style.Blue.Render(" Network round: ") +
+			fmt.Sprintf("%d (%d behind)", sync.NetworkRound, sync.Behind(m.Data.Status.LastRound))
+	}
 	end = getBitRate(m.Data.Metrics.TX) + style.Green.Render("TX ")
-	middle = strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
+	middle = sparklines(size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2), m.Data.Metrics.History.RoundTime, m.Data.Metrics.History.TX)
 
 	row2 := lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end)
 
@@ -108,19 +179,90 @@ func (m StatusViewModel) View() string {
 		tps = "--"
 	}
 	beginning = style.Blue.Render(" TPS: ") + tps
+	if syncing {
+		rate := "--"
+		if sync.Rate() > 0 {
+			rate = fmt.Sprintf("%.1f rounds/s", sync.Rate())
+		}
+		beginning = style.Blue.Render(" Sync rate: ") + rate
+		if eta, ok := sync.ETA(m.Data.Status.LastRound, m.Data.Metrics.RoundTime); ok {
+			beginning += style.Blue.Render(" ETA: ") + formatETA(eta)
+		}
+	}
 	end = getBitRate(m.Data.Metrics.RX) + style.Green.Render("RX ")
-	middle = strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
+	middle = sparklines(size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2), m.Data.Metrics.History.TPS, m.Data.Metrics.History.RX)
 
 	row3 := lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end)
 
-	return style.WithTitle("Status", style.ApplyBorder(max(0, size-2), 5, "5").Render(
+	// Node controls are only available with a local data directory
+	controls := ""
+	if m.Data.Node != nil {
+		controls = "( (S)tart | (X) stop | (R)estart )"
+	}
+
+	return style.WithControls(controls, style.WithTitle("Status", style.ApplyBorder(max(0, size-2), 5, "5").Render(
 		lipgloss.JoinVertical(lipgloss.Left,
 			row1,
-			"",
+			m.pinnedView(size-2),
 			style.Cyan.Render(" -- "+strconv.Itoa(m.Data.Metrics.Window)+" round average --"),
 			row2,
 			row3,
-		)))
+		))))
+}
+
+// sparklines fills the space between two values with their trends, or spaces without enough history
+func sparklines(width int, left internal.History, right internal.History) string {
+	width = max(0, width)
+	sparkWidth := min(20, (width-6)/2)
+	if sparkWidth < 4 || left.Len() < 2 || right.Len() < 2 {
+		return strings.Repeat(" ", width)
+	}
+	gap := strings.Repeat(" ", width-2*sparkWidth-4)
+	return "  " + style.Cyan.Render(style.Sparkline(left.Values(), sparkWidth)) + gap +
+		style.Cyan.Render(style.Sparkline(right.Values(), sparkWidth)) + "  "
+}
+
+// shortAddress keeps the start and the end of an address
+func shortAddress(address string) string {
+	if len(address) <= 12 {
+		return address
+	}
+	return address[:6] + "…" + address[len(address)-6:]
+}
+
+// pinnedView renders the notification or the pinned metrics on a single line
+func (m StatusViewModel) pinnedView(width int) string {
+	if m.Notification != "" {
+		notification := style.Green.Render(m.Notification)
+		if m.Alert {
+			notification = style.Red.Render(m.Notification)
+		}
+		return ansi.Truncate(" "+notification, max(0, width), "…")
+	}
+	// Replaying the blocks is slower than a fast catchup far behind the network
+	if m.Data.Status.State == internal.SyncingState && m.Data.Status.Sync.SuggestCatchup(m.Data.Status.LastRound) {
+		sync := m.Data.Status.Sync
+		suggestion := fmt.Sprintf("⚠ %d rounds behind, a fast catchup is faster: algorun catchup start <catchpoint>",
+			sync.Behind(m.Data.Status.LastRound))
+		// catchup start reads the catchpoint of the reference node
+		if round, _, ok := strings.Cut(sync.Catchpoint, "#"); ok {
+			suggestion = fmt.Sprintf("⚠ %d rounds behind, fast catchup to round %s with: algorun catchup start",
+				sync.Behind(m.Data.Status.LastRound), round)
+		}
+		return ansi.Truncate(" "+style.Yellow.Render(suggestion), max(0, width), "…")
+	}
+	if len(m.Pinned) == 0 {
+		return ""
+	}
+	values := make([]string, 0, len(m.Pinned))
+	for _, key := range m.Pinned {
+		value := "--"
+		if sample, ok := m.Data.Metrics.Families.Lookup(key); ok {
+			value = metrics.FormatValue(sample.Value)
+		}
+		values = append(values, style.Blue.Render(key+": ")+value)
+	}
+	return ansi.Truncate(" "+strings.Join(values, " | "), max(0, width), "…")
 }
 
 // MakeStatusViewModel constructs the model to be used in a tea.Program
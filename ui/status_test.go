@@ -12,6 +12,16 @@ import (
 	"github.com/charmbracelet/x/exp/teatest"
 )
 
+// getSync is a node syncing 200 rounds per second, far behind the reference node
+func getSync(catchpoint string) internal.SyncModel {
+	sync := internal.SyncModel{NetworkRound: 1_000_000, Catchpoint: catchpoint}
+	start := time.Unix(1700000000, 0)
+	for i := 0; i < 5; i++ {
+		sync.Sample(uint64(400_000+i*1000), start.Add(time.Duration(i)*internal.SyncSampleInterval))
+	}
+	return sync
+}
+
 var statusViewSnapshots = map[string]StatusViewModel{
 	"Syncing": {
 		Data: &internal.StateModel{
@@ -29,6 +39,133 @@ var statusViewSnapshots = map[string]StatusViewModel{
 		TerminalHeight: 80,
 		IsVisible:      true,
 	},
+	"NodeControls": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound:   1337,
+				NeedsUpdate: true,
+				State:       "SYNCING",
+			},
+			Metrics: internal.MetricsModel{
+				RoundTime: 0,
+				TX:        0,
+			},
+			Node: &internal.Node{DataDir: "/var/lib/algorand"},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"Pinned": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 1337,
+				State:     internal.StableState,
+			},
+			Metrics: internal.MetricsModel{
+				RoundTime: time.Second * 3,
+				Families: internal.MetricsResponse{
+					"algod_ledger_round": &internal.MetricFamily{
+						Name:    "algod_ledger_round",
+						Type:    internal.GaugeMetric,
+						Samples: []internal.Sample{{Name: "algod_ledger_round", Value: 1337}},
+					},
+				},
+			},
+		},
+		Pinned:         []string{"algod_ledger_round", "algod_peers"},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"Proposed": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 1337,
+				State:     internal.StableState,
+			},
+			Metrics: internal.MetricsModel{
+				RoundTime: time.Second * 3,
+			},
+		},
+		Pinned:         []string{"algod_ledger_round"},
+		Notification:   "★ ABCDEF…UVWXYZ proposed round 1337, earning 10 ALGO",
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"SyncReference": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 404_000,
+				State:     internal.SyncingState,
+				Sync:      getSync("390000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA"),
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"SyncCatchup": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 404_000,
+				State:     internal.SyncingState,
+				Sync:      getSync(""),
+			},
+		},
+		TerminalWidth:  80,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"Stalled": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 1337,
+				State:     internal.StableState,
+				Health:    internal.HealthModel{Stalled: true, TimeSinceLastRound: time.Second * 20},
+			},
+			Metrics: internal.MetricsModel{
+				RoundTime: time.Second * 3,
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"ClockSkew": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 1337,
+				State:     internal.StableState,
+				Health:    internal.HealthModel{ClockSkew: -time.Second * 42},
+			},
+			Metrics: internal.MetricsModel{
+				RoundTime: time.Second * 3,
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"History": {
+		Data: &internal.StateModel{
+			Status: internal.StatusModel{
+				LastRound: 1337,
+				State:     internal.StableState,
+			},
+			Metrics: internal.MetricsModel{
+				RoundTime: time.Second * 3,
+				TPS:       15,
+				RX:        20480,
+				TX:        40960,
+				History:   getHistory(),
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
 	"Hidden": {
 		Data: &internal.StateModel{
 			Status: internal.StatusModel{
@@ -72,6 +209,48 @@ func Test_StatusSnapshot(t *testing.T) {
 	}
 }
 
+func Test_StatusNotification(t *testing.T) {
+	m := MakeStatusViewModel(&internal.StateModel{})
+	m, cmd := m.HandleMessage(internal.BlockProposed{
+		Address: "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		Round:   1337,
+		Payout:  10_500_000,
+	})
+	if m.Notification != "★ ABCDEF…UVWXYZ proposed round 1337, earning 10.5 ALGO" || cmd == nil {
+		t.Fatalf("expected a notification, got %q", m.Notification)
+	}
+	m, _ = m.HandleMessage(internal.AbsenceRiskChanged{
+		Address:  "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		Previous: internal.HealthyRisk,
+		Risk:     internal.AtRisk,
+		Reason:   "no proposal for 1100 of 2000 rounds",
+	})
+	if m.Notification != "⚠ ABCDEF…UVWXYZ is at-risk, no proposal for 1100 of 2000 rounds" || !m.Alert {
+		t.Fatalf("expected an alert, got %q", m.Notification)
+	}
+	// An older notification expiring keeps the latest one
+	m, _ = m.HandleMessage(clearNotification{ID: 1})
+	if m.Notification == "" {
+		t.Error("expected the notification to stay")
+	}
+	m, _ = m.HandleMessage(internal.NodeStalled{Round: 1337, Since: time.Second * 20})
+	if m.Notification != "⚠ The node is stalled at round 1337, no round for 20s" || !m.Alert {
+		t.Fatalf("expected a stall alert, got %q", m.Notification)
+	}
+	m, _ = m.HandleMessage(internal.ClockSkewChanged{Skew: time.Minute, Skewed: true})
+	if m.Notification != "⚠ The local clock is +1m0s from the last block, check the time synchronization" || !m.Alert {
+		t.Fatalf("expected a clock alert, got %q", m.Notification)
+	}
+	m, _ = m.HandleMessage(internal.NodeResumed{Round: 1338})
+	if m.Notification != "✔ The node resumed at round 1338" || m.Alert {
+		t.Fatalf("expected the node to resume, got %q", m.Notification)
+	}
+	m, _ = m.HandleMessage(clearNotification{ID: 5})
+	if m.Notification != "" || m.Alert {
+		t.Errorf("expected the notification to be cleared, got %q", m.Notification)
+	}
+}
+
 func Test_StatusMessages(t *testing.T) {
 	state := internal.StateModel{
 		Status: internal.StatusModel{
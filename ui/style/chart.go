@@ -0,0 +1,104 @@
+package style
+
+import (
+	"strings"
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// brailleDots are the bits of a braille cell, indexed by column then row from the top
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// scale maps the value between low and high to 0..steps-1
+func scale(value float64, low float64, high float64, steps int) int {
+	if high <= low {
+		return 0
+	}
+	return min(steps-1, max(0, int((value-low)/(high-low)*float64(steps-1)+0.5)))
+}
+
+func bounds(values []float64) (float64, float64) {
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low = min(low, value)
+		high = max(high, value)
+	}
+	return low, high
+}
+
+// Sparkline renders the newest values in a single line of block characters, right aligned to width
+func Sparkline(values []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	if len(values) == 0 {
+		return strings.Repeat(" ", width)
+	}
+	low, high := bounds(values)
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(values)))
+	for _, value := range values {
+		b.WriteRune(sparks[scale(value, low, high, len(sparks))])
+	}
+	return b.String()
+}
+
+// BrailleChart renders the newest values as a line chart of width by height braille cells,
+// each cell holds two values
+func BrailleChart(values []float64, width int, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+	columns := width * 2
+	rows := height * 4
+	if len(values) > columns {
+		values = values[len(values)-columns:]
+	}
+	cells := make([][]rune, height)
+	for i := range cells {
+		cells[i] = make([]rune, width)
+	}
+	set := func(x int, y int) {
+		// y is counted from the bottom
+		row := rows - 1 - y
+		cells[row/4][x/2] |= brailleDots[x%2][row%4]
+	}
+
+	if len(values) > 0 {
+		low, high := bounds(values)
+		offset := columns - len(values)
+		previous := -1
+		for i, value := range values {
+			y := scale(value, low, high, rows)
+			// Connect the points so steep changes stay visible
+			from, to := y, y
+			if previous >= 0 {
+				from, to = min(previous, y), max(previous, y)
+			}
+			for dot := from; dot <= to; dot++ {
+				set(offset+i, dot)
+			}
+			previous = y
+		}
+	}
+
+	lines := make([]string, height)
+	for i, row := range cells {
+		var b strings.Builder
+		for _, cell := range row {
+			if cell == 0 {
+				b.WriteRune(' ')
+			} else {
+				b.WriteRune(0x2800 + cell)
+			}
+		}
+		lines[i] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
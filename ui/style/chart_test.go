@@ -0,0 +1,56 @@
+package style
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_Sparkline(t *testing.T) {
+	if got := Sparkline([]float64{0, 1, 2, 3, 4, 5, 6, 7}, 8); got != "▁▂▃▄▅▆▇█" {
+		t.Errorf("expected every level, got %s", got)
+	}
+	if got := Sparkline([]float64{1, 2}, 4); got != "  ▁█" {
+		t.Errorf("expected the values right aligned, got %q", got)
+	}
+	if got := Sparkline([]float64{5, 5, 5, 0, 10}, 2); got != "▁█" {
+		t.Errorf("expected the newest values, got %q", got)
+	}
+	if got := Sparkline([]float64{3, 3}, 2); got != "▁▁" {
+		t.Errorf("expected flat values at the bottom, got %q", got)
+	}
+	if got := Sparkline(nil, 3); got != "   " {
+		t.Errorf("expected spaces, got %q", got)
+	}
+	if got := Sparkline([]float64{1}, 0); got != "" {
+		t.Errorf("expected nothing, got %q", got)
+	}
+}
+
+func Test_BrailleChart(t *testing.T) {
+	// The bottom left dot is connected to the top right dot through the right column
+	if got := BrailleChart([]float64{0, 1}, 1, 1); got != "⣸" {
+		t.Errorf("unexpected chart %q", got)
+	}
+
+	chart := BrailleChart([]float64{1, 5, 2, 8, 3, 9}, 4, 3)
+	lines := strings.Split(chart, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if len([]rune(line)) != 4 {
+			t.Errorf("expected 4 cells, got %q", line)
+		}
+	}
+	// Values are right aligned
+	if []rune(lines[2])[0] != ' ' {
+		t.Errorf("expected the first cell to be empty, got %q", lines[2])
+	}
+
+	if BrailleChart(nil, 0, 3) != "" {
+		t.Error("expected nothing without a width")
+	}
+	if got := BrailleChart(nil, 2, 2); got != "  \n  " {
+		t.Errorf("expected an empty chart, got %q", got)
+	}
+}
@@ -7,12 +7,21 @@ import (
 	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/ui/app"
 	"github.com/algorandfoundation/algorun-tui/ui/modal"
+	"github.com/algorandfoundation/algorun-tui/ui/pages/account"
 	"github.com/algorandfoundation/algorun-tui/ui/pages/accounts"
 	"github.com/algorandfoundation/algorun-tui/ui/pages/keys"
+	"github.com/algorandfoundation/algorun-tui/ui/pages/metrics"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// nodeActions maps the header keys to the node controls
+var nodeActions = map[string]app.NodeAction{
+	"S": app.StartNode,
+	"X": app.StopNode,
+	"R": app.RestartNode,
+}
+
 // ViewportViewModel represents the state and view model for a viewport in the application.
 type ViewportViewModel struct {
 	PageWidth, PageHeight         int
@@ -26,7 +35,16 @@ type ViewportViewModel struct {
 
 	// Pages
 	accountsPage accounts.ViewModel
+	accountPage  account.ViewModel
 	keysPage     keys.ViewModel
+	metricsPage  metrics.ViewModel
+
+	// Shown in place of the page during a fast catchup
+	catchup CatchupViewModel
+
+	// Full screen charts toggled with (d)ashboard
+	dashboard     DashboardViewModel
+	showDashboard bool
 
 	modal  *modal.ViewModel
 	page   app.Page
@@ -55,24 +73,70 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg == app.KeysPage {
 			m.keysPage.Address = m.accountsPage.SelectedAccount().Address
 		}
+		if msg == app.AccountPage {
+			m.accountPage.Address = m.accountsPage.SelectedAccount().Address
+		}
 		m.page = msg
 	// When the state updates
 	case internal.StateModel:
 		m.Data = &msg
 		m.accountsPage, cmd = m.accountsPage.HandleMessage(msg)
 		cmds = append(cmds, cmd)
+		m.accountPage, cmd = m.accountPage.HandleMessage(msg)
+		cmds = append(cmds, cmd)
 		m.keysPage, cmd = m.keysPage.HandleMessage(msg)
 		cmds = append(cmds, cmd)
+		m.metricsPage, cmd = m.metricsPage.HandleMessage(msg)
+		cmds = append(cmds, cmd)
+		m.catchup, cmd = m.catchup.HandleMessage(msg)
+		cmds = append(cmds, cmd)
+		m.dashboard, cmd = m.dashboard.HandleMessage(msg)
+		cmds = append(cmds, cmd)
 		m.modal, cmd = m.modal.HandleMessage(msg)
 		cmds = append(cmds, cmd)
 		return m, tea.Batch(cmds...)
+	case app.PinnedMetrics:
+		m.metricsPage, cmd = m.metricsPage.HandleMessage(msg)
+		cmds = append(cmds, cmd)
+		return m, tea.Batch(cmds...)
+	case app.NodeActionFinished:
+		if msg.Err != nil {
+			m.modal, cmd = m.modal.HandleMessage(msg.Err)
+			cmds = append(cmds, cmd)
+			return m, tea.Batch(cmds...)
+		}
 	case app.DeleteFinished:
 		if len(m.keysPage.Rows()) <= 1 {
 			cmd = app.EmitShowPage(app.AccountsPage)
 			cmds = append(cmds, cmd)
 		}
 	case tea.KeyMsg:
+		// The metrics filter takes every key while it is focused
+		if !m.modal.Open && m.page == app.MetricsPage && m.metricsPage.Filtering() {
+			m.metricsPage, cmd = m.metricsPage.HandleMessage(msg)
+			cmds = append(cmds, cmd)
+			return m, tea.Batch(cmds...)
+		}
+		// The dashboard only closes or quits
+		if m.showDashboard && !m.modal.Open {
+			switch msg.String() {
+			case "d", "esc":
+				m.showDashboard = false
+			case "q":
+				return m, tea.Quit
+			}
+			return m, nil
+		}
 		switch msg.String() {
+		case "d":
+			if !m.modal.Open {
+				m.showDashboard = true
+				return m, nil
+			}
+		case "m":
+			if !m.modal.Open {
+				return m, app.EmitShowPage(app.MetricsPage)
+			}
 		case "g":
 			// Only open modal when it is closed and not syncing
 			if !m.modal.Open && m.Data.Status.State == internal.StableState && m.Data.Metrics.RoundTime > 0 {
@@ -93,13 +157,21 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Batch(cmds...)
 			}
 
+		case "S", "X", "R":
+			// Control the local node when algorun knows the data directory, once confirmed
+			if !m.modal.Open && m.Data.Node != nil {
+				return m, app.EmitModalEvent(app.ModalEvent{
+					Type:   app.ConfirmModal,
+					Action: nodeActions[msg.String()],
+				})
+			}
 		case "left":
 			// Disable when overlay is active or on Accounts
 			if m.modal.Open || m.page == app.AccountsPage {
 				return m, nil
 			}
-			// Navigate to the Keys Page
-			if m.page == app.KeysPage {
+			// Navigate back to the Accounts Page
+			if m.page == app.KeysPage || m.page == app.MetricsPage || m.page == app.AccountPage {
 				return m, app.EmitShowPage(app.AccountsPage)
 			}
 		case "right":
@@ -127,6 +199,8 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.TerminalWidth = msg.Width
 		m.TerminalHeight = msg.Height
+		m.dashboard, cmd = m.dashboard.HandleMessage(msg)
+		cmds = append(cmds, cmd)
 		m.PageWidth = msg.Width
 		m.PageHeight = max(0, msg.Height-lipgloss.Height(m.headerView()))
 
@@ -148,9 +222,18 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.accountsPage, cmd = m.accountsPage.HandleMessage(pageMsg)
 		cmds = append(cmds, cmd)
 
+		m.accountPage, cmd = m.accountPage.HandleMessage(pageMsg)
+		cmds = append(cmds, cmd)
+
 		m.keysPage, cmd = m.keysPage.HandleMessage(pageMsg)
 		cmds = append(cmds, cmd)
 
+		m.metricsPage, cmd = m.metricsPage.HandleMessage(pageMsg)
+		cmds = append(cmds, cmd)
+
+		m.catchup, cmd = m.catchup.HandleMessage(pageMsg)
+		cmds = append(cmds, cmd)
+
 		// Avoid triggering commands again
 		return m, tea.Batch(cmds...)
 	}
@@ -161,8 +244,12 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch m.page {
 		case app.AccountsPage:
 			m.accountsPage, cmd = m.accountsPage.HandleMessage(msg)
+		case app.AccountPage:
+			m.accountPage, cmd = m.accountPage.HandleMessage(msg)
 		case app.KeysPage:
 			m.keysPage, cmd = m.keysPage.HandleMessage(msg)
+		case app.MetricsPage:
+			m.metricsPage, cmd = m.metricsPage.HandleMessage(msg)
 		}
 		cmds = append(cmds, cmd)
 	}
@@ -182,14 +269,27 @@ func (m ViewportViewModel) View() string {
 	switch m.page {
 	case app.AccountsPage:
 		page = m.accountsPage
+	case app.AccountPage:
+		page = m.accountPage
 	case app.KeysPage:
 		page = m.keysPage
+	case app.MetricsPage:
+		page = m.metricsPage
+	}
+	// The catchup progress replaces the page until the node is synced
+	if m.Data != nil && m.Data.Status.State == internal.FastCatchupState {
+		page = m.catchup
 	}
 
 	if page == nil {
 		return "Error loading page..."
 	}
 
+	// Modals are shown over the page instead
+	if m.showDashboard && !m.modal.Open {
+		return m.dashboard.View()
+	}
+
 	m.modal.Parent = fmt.Sprintf("%s\n%s", m.headerView(), page.View())
 	return m.modal.View()
 }
@@ -221,7 +321,11 @@ func NewViewportViewModel(state *internal.StateModel, client api.ClientWithRespo
 
 		// Pages
 		accountsPage: accounts.New(state),
+		accountPage:  account.New(state),
 		keysPage:     keys.New("", state.ParticipationKeys),
+		metricsPage:  metrics.New(state),
+		catchup:      MakeCatchupViewModel(state),
+		dashboard:    MakeDashboardViewModel(state),
 
 		// Modal
 		modal: modal.New("", false, state),
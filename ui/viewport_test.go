@@ -2,7 +2,10 @@ package ui
 
 import (
 	"bytes"
+	"context"
+	"github.com/algorandfoundation/algorun-tui/internal"
 	"github.com/algorandfoundation/algorun-tui/internal/test"
+	"github.com/algorandfoundation/algorun-tui/internal/test/fake"
 	"github.com/algorandfoundation/algorun-tui/ui/app"
 	uitest "github.com/algorandfoundation/algorun-tui/ui/internal/test"
 	"testing"
@@ -51,12 +54,48 @@ func Test_ViewportViewRender(t *testing.T) {
 		Type:  tea.KeyRunes,
 		Runes: []rune("right"),
 	})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("──Keys"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("left"),
+	})
 
 	tm.Send(tea.KeyMsg{
 		Type:  tea.KeyRunes,
 		Runes: []rune("left"),
 	})
 
+	// The arrow keys change pages with commands, wait for the accounts page
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("──Accounts"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	// Open the account details and go back
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("i"),
+	})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Registered Participation"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
 	tm.Send(tea.KeyMsg{
 		Type:  tea.KeyRunes,
 		Runes: []rune("left"),
@@ -69,3 +108,199 @@ func Test_ViewportViewRender(t *testing.T) {
 
 	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
 }
+
+func Test_ViewportCatchupRender(t *testing.T) {
+	client := test.GetClient(false)
+	state := uitest.GetState(client)
+	state.Status.State = internal.FastCatchupState
+	state.Status.Catchpoint.Label = "4420000#Q7T2RRTDIRTYESIXKAAFJYFQWG4A3WRA3JIUZVCJ3F4AQ2G2HZRA"
+	m, err := NewViewportViewModel(state, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(160, 40),
+	)
+
+	// The catchup progress replaces the accounts page
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Fast Catchup"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(*state)
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("q"),
+	})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func Test_ViewportNodeActions(t *testing.T) {
+	client := test.GetClient(false)
+	state := uitest.GetState(client)
+	state.Node = &internal.Node{
+		Binary:  "algod-does-not-exist",
+		DataDir: t.TempDir(),
+	}
+	m, err := NewViewportViewModel(state, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(160, 40),
+	)
+
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("(S)tart"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	// Starting a missing binary shows the error, once confirmed
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("S"),
+	})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Are you sure you want to start the node?"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("y"),
+	})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("algod-does-not-exist"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("q"),
+	})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func Test_ViewportMetricsPage(t *testing.T) {
+	client := test.GetClient(false)
+	state := uitest.GetState(client)
+	families, err := internal.GetMetrics(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.Metrics.Families = families
+	m, err := NewViewportViewModel(state, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(160, 40),
+	)
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Rate/s"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	// Keys go to the filter instead of quitting
+	for _, key := range []string{"/", "q", "r", "a", "m"} {
+		tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ram")})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Pinning shows the metric in the status header
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("algod_ram_usage: 0"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("q"),
+	})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func Test_ViewportFakeAlgod(t *testing.T) {
+	algod := fake.New(fake.WithRound(1336), fake.WithRoundTime(time.Millisecond*50))
+	defer algod.Close()
+	client := algod.Client()
+	state := uitest.GetState(client)
+	m, err := NewViewportViewModel(state, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(160, 40),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher := internal.NewWatcher(state, client)
+	updates, _ := watcher.Subscribe(16)
+	go func() {
+		_ = watcher.Run(ctx)
+	}()
+	go func() {
+		for update := range updates {
+			tm.Send(update.State)
+		}
+	}()
+
+	// The header follows the rounds produced by the fake node
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Latest Round: 1340"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("q"),
+	})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}